@@ -0,0 +1,93 @@
+package params
+
+import "encoding/json"
+
+// Any holds a JSON value of any type, e.g. a field that may be a string,
+// a number or a boolean, whose interpretation is decided after decoding.
+type Any struct {
+	value   any  // Value holds the decoded Go value
+	present bool // Present indicates if the value is present or not
+}
+
+// UnmarshalJSON implements custom unmarshalling for the Any type.
+// The data is decoded with json.Unmarshal into an interface value, so objects
+// become map[string]any, arrays []any, numbers float64 and so on.
+// If the value is null, it sets Present to false.
+//
+// Parameters:
+//   - data: The JSON data to unmarshal into the Any type.
+//
+// Returns:
+//   - error: An error if the unmarshalling fails, otherwise nil.
+func (a *Any) UnmarshalJSON(data []byte) error {
+	a.value = nil
+	a.present = false
+
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	a.value = v
+	a.present = true
+
+	return nil
+}
+
+// MarshalJSON implements custom marshalling for the Any type.
+// It marshals the stored value, or returns null if the value is not present.
+//
+// Returns:
+//   - []byte: The JSON representation of the Any type.
+//   - error: An error if the marshalling fails, otherwise nil.
+func (a Any) MarshalJSON() ([]byte, error) {
+	if !a.present {
+		return []byte("null"), nil
+	}
+	return json.Marshal(a.value)
+}
+
+// Set sets the value of the Any type and marks it as present.
+//
+// Parameters:
+//   - value: The value to set for the Any type.
+func (a *Any) Set(value any) {
+	a.value = value
+	a.present = true
+}
+
+// Value retrieves the stored value of the Any type.
+// If the value is not present, it returns nil.
+//
+// Returns:
+//   - any: The stored value if present, otherwise nil.
+func (a *Any) Value() any {
+	if !a.present {
+		return nil
+	}
+	return a.value
+}
+
+// MustValue is like Value but panics if the value is not present.
+// Like Int.MustValue, it is meant for tests, not for request-handling code.
+//
+// Returns:
+//   - any: The value of the Any type.
+func (a *Any) MustValue() any {
+	if !a.present {
+		panic("params: MustValue called on an absent Any")
+	}
+	return a.value
+}
+
+// Present checks if the Any type is present in the JSON payload.
+// It returns true if a non-null value was provided in the JSON payload, otherwise false.
+//
+// Returns:
+//   - bool: True if the value is present, otherwise false.
+func (a *Any) Present() bool {
+	return a.present
+}
@@ -0,0 +1,61 @@
+package params
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAny(t *testing.T) {
+	type result struct {
+		Field Any `json:"field"`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		output  string
+		value   any
+		present bool
+		wantErr bool
+	}{
+		{name: "string", input: `{"field":"text"}`, value: "text", present: true},
+		{name: "number", input: `{"field":12.5}`, value: 12.5, present: true},
+		{name: "boolean", input: `{"field":false}`, value: false, present: true},
+		{name: "object", input: `{"field":{"a":1}}`, value: map[string]any{"a": float64(1)}, present: true},
+		{name: "array", input: `{"field":[1,"b"]}`, value: []any{float64(1), "b"}, present: true},
+		{name: "null", input: `{"field":null}`},
+		{name: "missing", input: `{}`, output: `{"field":null}`},
+		{name: "invalid JSON", input: `{"field":tru}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.output == "" {
+				tt.output = tt.input
+			}
+			var test result
+			err := json.Unmarshal([]byte(tt.input), &test)
+			if tt.wantErr {
+				require.Error(t, err, "Unmarshal should return an error")
+				return
+			}
+			require.NoError(t, err, "Unmarshal should not return an error")
+			require.Equal(t, tt.value, test.Field.Value(), "Value mismatch")
+			require.Equal(t, tt.present, test.Field.Present(), "Present mismatch")
+
+			js, err := json.Marshal(test)
+			require.NoError(t, err, "Marshal should not return an error")
+			require.JSONEq(t, tt.output, string(js), "Marshalled JSON mismatch")
+		})
+	}
+}
+
+func TestAny_MustValue(t *testing.T) {
+	var a Any
+	require.Panics(t, func() { a.MustValue() }, "MustValue should panic when absent")
+
+	a.Set(nil)
+	require.Nil(t, a.MustValue(), "MustValue mismatch")
+}
@@ -2,12 +2,15 @@ package params
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
 type Bool struct {
 	value   bool // Value holds the actual boolean value
 	present bool // Present indicates if the boolean is present or not
+	quoted  bool // Quoted marshals the boolean as a JSON string
+	numeric bool // Numeric accepts 1 and 0 as true and false
 }
 
 // UnmarshalJSON implements custom unmarshalling for the Bool type.
@@ -15,6 +18,7 @@ type Bool struct {
 // If the boolean is null, it sets Present to false and Value to false.
 // If the boolean is quoted, it removes the quotes and sets Present to true.
 // If the boolean is not quoted, it sets Present to true and retains the value as is.
+// Numeric 1 and 0 (quoted or not) are accepted only if SetAcceptNumeric is enabled.
 // This allows for flexible handling of boolean values in JSON payloads.
 func (b *Bool) UnmarshalJSON(data []byte) error {
 	b.present = false
@@ -26,10 +30,10 @@ func (b *Bool) UnmarshalJSON(data []byte) error {
 
 	str := strings.ToLower(strings.Trim(string(data), `"`))
 
-	switch str {
-	case "true":
+	switch {
+	case str == "true", b.numeric && str == "1":
 		b.value = true
-	case "false":
+	case str == "false", b.numeric && str == "0":
 		b.value = false
 	default:
 		return fmt.Errorf("invalid boolean format: %s", string(data))
@@ -54,7 +58,12 @@ func (b *Bool) UnmarshalText(text []byte) error {
 }
 
 // UnmarshalParam is a helper method to unmarshal a string parameter directly.
-// It converts the string parameter to a byte slice and calls UnmarshalJSON.
+// Unquoted "true" and "false" (case-insensitive) are matched without allocating.
+// It also follows the HTML checkbox convention: a checked box submits "on",
+// which is read as true, and an empty value is read as a present false
+// (unchecked). A checkbox omitted from the form never reaches UnmarshalParam
+// and therefore stays absent.
+// Anything else falls back to UnmarshalJSON.
 //
 // Parameters:
 //   - param: The string parameter to unmarshal into the Bool type.
@@ -62,9 +71,40 @@ func (b *Bool) UnmarshalText(text []byte) error {
 // Returns:
 //   - error: An error if the unmarshalling fails, otherwise nil.
 func (b *Bool) UnmarshalParam(param string) error {
+	switch {
+	case param == "":
+		b.Set(false)
+		return nil
+	case strings.EqualFold(param, "true"), strings.EqualFold(param, "on"):
+		b.Set(true)
+		return nil
+	case strings.EqualFold(param, "false"):
+		b.Set(false)
+		return nil
+	}
 	return b.UnmarshalJSON([]byte(param))
 }
 
+// SetAcceptNumeric enables or disables numeric booleans.
+// When enabled, UnmarshalJSON and UnmarshalParam accept 1 as true and 0 as false.
+// It is disabled by default, so integers are rejected where strict booleans are required.
+//
+// Parameters:
+//   - numeric: True to accept 1 and 0 as booleans.
+func (b *Bool) SetAcceptNumeric(numeric bool) {
+	b.numeric = numeric
+}
+
+// SetQuotedOutput enables or disables quoted output in MarshalJSON.
+// When enabled, a present boolean is marshalled as "true" or "false" instead
+// of a bare JSON boolean. It is disabled by default.
+//
+// Parameters:
+//   - quoted: True to marshal the boolean as a JSON string.
+func (b *Bool) SetQuotedOutput(quoted bool) {
+	b.quoted = quoted
+}
+
 // Set sets the value of the Bool type and marks it as present.
 // This method updates the Value field with the provided boolean and sets Present to true.
 //
@@ -75,6 +115,54 @@ func (b *Bool) Set(value bool) {
 	b.present = true
 }
 
+// Scan implements the sql.Scanner interface.
+// It accepts bool sources, int64 sources (nonzero is true) as stored by MySQL,
+// and string or []byte sources holding "t", "f", "true", "false", "1" or "0"
+// as returned by Postgres drivers. A nil source sets Present to false.
+//
+// Parameters:
+//   - src: The database value to scan into the Bool type.
+//
+// Returns:
+//   - error: An error if the source type or text is not supported, otherwise nil.
+func (b *Bool) Scan(src any) error {
+	b.value = false
+	b.present = false
+
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case bool:
+		b.value = v
+	case int64:
+		b.value = v != 0
+	case string:
+		return b.scanText(v)
+	case []byte:
+		return b.scanText(string(v))
+	default:
+		return fmt.Errorf("unsupported Scan source type for Bool: %T", src)
+	}
+	b.present = true
+
+	return nil
+}
+
+// scanText stores the boolean spelled by a textual database value.
+func (b *Bool) scanText(str string) error {
+	switch str {
+	case "t", "true", "1":
+		b.value = true
+	case "f", "false", "0":
+		b.value = false
+	default:
+		return fmt.Errorf("invalid boolean format: %s", str)
+	}
+	b.present = true
+
+	return nil
+}
+
 // Value retrieves the value of the Bool type.
 // If the boolean is not present, it returns false.
 // If the boolean is present, it returns the Value field.
@@ -88,6 +176,34 @@ func (b *Bool) Value() bool {
 	return b.value
 }
 
+// ValueOr retrieves the value of the Bool type, or def if it is not present.
+// Unlike Value, which falls back to false, this lets feature flags that
+// default to on be read in a single call.
+//
+// Parameters:
+//   - def: The value to return if the boolean is not present.
+//
+// Returns:
+//   - bool: The value if present, otherwise def.
+func (b *Bool) ValueOr(def bool) bool {
+	if !b.present {
+		return def
+	}
+	return b.value
+}
+
+// MustValue is like Value but panics if the boolean is not present.
+// Like Int.MustValue, it is meant for tests, not for request-handling code.
+//
+// Returns:
+//   - bool: The value of the Bool type.
+func (b *Bool) MustValue() bool {
+	if !b.present {
+		panic("params: MustValue called on an absent Bool")
+	}
+	return b.value
+}
+
 // Present checks if the Bool type is present in the JSON payload.
 // It returns true if the boolean was provided in the JSON payload, otherwise false.
 //
@@ -99,7 +215,8 @@ func (b *Bool) Present() bool {
 
 // MarshalJSON implements custom marshalling for the Bool type.
 // It converts the Bool type to a JSON boolean representation.
-// If the boolean is not present, it returns an empty JSON string.
+// If the boolean is not present, it returns null.
+// If quoted output is enabled, it returns "true" or "false" as a JSON string.
 //
 // Returns:
 //   - []byte: The JSON representation of the Bool type.
@@ -108,8 +225,153 @@ func (b Bool) MarshalJSON() ([]byte, error) {
 	if !b.present {
 		return []byte("null"), nil
 	}
+	if b.quoted {
+		return strconv.AppendQuote(nil, strconv.FormatBool(b.value)), nil
+	}
 	if b.value {
 		return []byte("true"), nil
 	}
 	return []byte("false"), nil
 }
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns "true" or "false", or empty bytes if the boolean is not present.
+//
+// Returns:
+//   - []byte: The text representation of the Bool type.
+//   - error: An error if the marshalling fails, otherwise nil.
+func (b Bool) MarshalText() ([]byte, error) {
+	if !b.present {
+		return []byte{}, nil
+	}
+	return strconv.AppendBool(nil, b.value), nil
+}
+
+// IsTrue reports whether the boolean is present and true.
+//
+// Returns:
+//   - bool: True if the boolean is present and true, otherwise false.
+func (b *Bool) IsTrue() bool {
+	return b.present && b.value
+}
+
+// IsFalse reports whether the boolean is present and false.
+// Unlike !Value(), it returns false for an absent Bool, so an explicit
+// false can be told apart from an omitted one.
+//
+// Returns:
+//   - bool: True if the boolean is present and false, otherwise false.
+func (b *Bool) IsFalse() bool {
+	return b.present && !b.value
+}
+
+// Toggle returns a copy of the Bool with the value negated.
+// The present flag is preserved, so an absent Bool stays absent.
+//
+// Returns:
+//   - Bool: A new Bool with the negated value.
+func (b *Bool) Toggle() Bool {
+	t := *b
+	if t.present {
+		t.value = !t.value
+	}
+	return t
+}
+
+// String implements the fmt.Stringer interface.
+// It returns "null" if the boolean is not present, otherwise "true" or "false".
+//
+// Returns:
+//   - string: The string representation of the Bool type.
+func (b Bool) String() string {
+	if !b.present {
+		return "null"
+	}
+	return strconv.FormatBool(b.value)
+}
+
+// Ptr returns a pointer to a copy of the boolean value, or nil if it is not present.
+// It converts the Bool type to the pointer-based optional form used by many generated clients.
+//
+// Returns:
+//   - *bool: A pointer to the value if present, otherwise nil.
+func (b *Bool) Ptr() *bool {
+	if !b.present {
+		return nil
+	}
+	v := b.value
+	return &v
+}
+
+// BoolFromPtr creates a Bool from a pointer-based optional value.
+// A nil pointer yields an absent Bool, otherwise the pointed-to value is copied and marked present.
+// BoolFromPtr(v.Ptr()) keeps the value and presence of v, but not options such as SetQuotedOutput.
+//
+// Parameters:
+//   - p: The pointer to convert.
+//
+// Returns:
+//   - Bool: The converted Bool.
+func BoolFromPtr(p *bool) Bool {
+	var v Bool
+	if p != nil {
+		v.Set(*p)
+	}
+	return v
+}
+
+// AllTrue reduces vals with three-valued (Kleene) AND, treating an absent Bool as unknown.
+// The result is a present false if any value is a present false, an absent Bool
+// if the outcome is undetermined because of absent values, and a present true otherwise.
+// With no arguments it returns a present true, the identity of AND.
+//
+// Parameters:
+//   - vals: The values to combine.
+//
+// Returns:
+//   - Bool: The combined value, present only if the outcome is determined.
+func AllTrue(vals ...Bool) Bool {
+	var result Bool
+	unknown := false
+	for _, v := range vals {
+		switch {
+		case !v.present:
+			unknown = true
+		case !v.value:
+			result.Set(false)
+			return result
+		}
+	}
+	if !unknown {
+		result.Set(true)
+	}
+	return result
+}
+
+// AnyTrue reduces vals with three-valued (Kleene) OR, treating an absent Bool as unknown.
+// The result is a present true if any value is a present true, an absent Bool
+// if the outcome is undetermined because of absent values, and a present false otherwise.
+// With no arguments it returns a present false, the identity of OR.
+//
+// Parameters:
+//   - vals: The values to combine.
+//
+// Returns:
+//   - Bool: The combined value, present only if the outcome is determined.
+func AnyTrue(vals ...Bool) Bool {
+	var result Bool
+	unknown := false
+	for _, v := range vals {
+		switch {
+		case !v.present:
+			unknown = true
+		case v.value:
+			result.Set(true)
+			return result
+		}
+	}
+	if !unknown {
+		result.Set(false)
+	}
+	return result
+}
@@ -2,6 +2,7 @@ package params
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -123,3 +124,273 @@ func TestBool(t *testing.T) {
 		})
 	}
 }
+
+func TestBool_IsTrueIsFalse(t *testing.T) {
+	var b Bool
+	require.False(t, b.IsTrue(), "absent Bool should not be true")
+	require.False(t, b.IsFalse(), "absent Bool should not be false")
+
+	b.Set(true)
+	require.True(t, b.IsTrue(), "IsTrue mismatch")
+	require.False(t, b.IsFalse(), "IsFalse mismatch")
+
+	b.Set(false)
+	require.False(t, b.IsTrue(), "IsTrue mismatch")
+	require.True(t, b.IsFalse(), "IsFalse mismatch")
+}
+
+func TestBool_Toggle(t *testing.T) {
+	var absent Bool
+	toggled := absent.Toggle()
+	require.False(t, toggled.Present(), "absent Bool should stay absent")
+	require.False(t, toggled.Value(), "absent Bool should have false value")
+
+	var b Bool
+	b.Set(true)
+	toggled = b.Toggle()
+	require.True(t, toggled.Present(), "toggled Bool should be present")
+	require.False(t, toggled.Value(), "true should toggle to false")
+	require.True(t, b.Value(), "original Bool should not change")
+
+	toggled = toggled.Toggle()
+	require.True(t, toggled.Value(), "false should toggle to true")
+}
+
+func TestBool_UnmarshalParam(t *testing.T) {
+	tests := []struct {
+		name    string
+		param   string
+		value   bool
+		present bool
+		wantErr bool
+	}{
+		{name: "true", param: "true", value: true, present: true},
+		{name: "false", param: "false", value: false, present: true},
+		{name: "mixed case", param: "TrUe", value: true, present: true},
+		{name: "quoted", param: `"false"`, value: false, present: true},
+		{name: "empty is unchecked", param: "", value: false, present: true},
+		{name: "checkbox on", param: "on", value: true, present: true},
+		{name: "checkbox ON", param: "ON", value: true, present: true},
+		{name: "null", param: "null", present: false},
+		{name: "invalid", param: "yes", wantErr: true},
+		{name: "checkbox off", param: "off", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Bool
+			err := b.UnmarshalParam(tt.param)
+			if tt.wantErr {
+				require.Error(t, err, "UnmarshalParam should return an error")
+				return
+			}
+			require.NoError(t, err, "UnmarshalParam should not return an error")
+			require.Equal(t, tt.value, b.Value(), "Value mismatch")
+			require.Equal(t, tt.present, b.Present(), "Present mismatch")
+		})
+	}
+}
+
+func BenchmarkBool_UnmarshalParam(b *testing.B) {
+	b.Run("param", func(b *testing.B) {
+		b.ReportAllocs()
+		var v Bool
+		for b.Loop() {
+			_ = v.UnmarshalParam("True")
+		}
+	})
+	b.Run("json", func(b *testing.B) {
+		b.ReportAllocs()
+		var v Bool
+		for b.Loop() {
+			_ = v.UnmarshalJSON([]byte("True"))
+		}
+	})
+}
+
+func TestBool_String(t *testing.T) {
+	var b Bool
+	require.Equal(t, "null", b.String(), "absent Bool should print as null")
+	b.Set(false)
+	require.Equal(t, "false", b.String(), "present Bool should print its value")
+	require.Equal(t, "false", fmt.Sprintf("%s", b), "%%s should use String()")
+}
+
+func TestBool_SetQuotedOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   bool
+		present bool
+		want    string
+	}{
+		{name: "true", value: true, present: true, want: `"true"`},
+		{name: "false", value: false, present: true, want: `"false"`},
+		{name: "absent", present: false, want: `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Bool
+			b.SetQuotedOutput(true)
+			if tt.present {
+				b.Set(tt.value)
+			}
+			got, err := json.Marshal(b)
+			require.NoError(t, err, "Marshal should not return an error")
+			require.Equal(t, tt.want, string(got), "MarshalJSON mismatch")
+		})
+	}
+}
+
+func TestBool_Ptr(t *testing.T) {
+	var absent Bool
+	require.Nil(t, absent.Ptr(), "absent Bool should return nil")
+	fromNil := BoolFromPtr(nil)
+	require.False(t, fromNil.Present(), "nil pointer should yield an absent Bool")
+
+	var v Bool
+	v.Set(true)
+	p := v.Ptr()
+	require.NotNil(t, p, "present Bool should return a pointer")
+	require.Equal(t, true, *p, "Ptr value mismatch")
+
+	back := BoolFromPtr(p)
+	require.True(t, back.Present(), "BoolFromPtr should mark the value present")
+	require.Equal(t, v.Value(), back.Value(), "round trip mismatch")
+	roundTrip := BoolFromPtr(absent.Ptr())
+	require.False(t, roundTrip.Present(), "absent round trip should stay absent")
+}
+
+func TestBool_SetAcceptNumeric(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		numeric bool
+		value   bool
+		wantErr bool
+	}{
+		{name: "one rejected by default", data: `1`, wantErr: true},
+		{name: "zero rejected by default", data: `"0"`, wantErr: true},
+		{name: "one accepted", data: `1`, numeric: true, value: true},
+		{name: "quoted zero accepted", data: `"0"`, numeric: true, value: false},
+		{name: "two rejected", data: `2`, numeric: true, wantErr: true},
+		{name: "true still accepted", data: `true`, numeric: true, value: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Bool
+			b.SetAcceptNumeric(tt.numeric)
+			err := b.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err, "UnmarshalJSON should return an error")
+				require.False(t, b.Present(), "Bool should not be present")
+				return
+			}
+			require.NoError(t, err, "UnmarshalJSON should not return an error")
+			require.Equal(t, tt.value, b.Value(), "Value mismatch")
+			require.True(t, b.Present(), "Bool should be present")
+		})
+	}
+
+	var b Bool
+	b.SetAcceptNumeric(true)
+	require.NoError(t, b.UnmarshalParam("1"), "UnmarshalParam should accept 1 when enabled")
+	require.True(t, b.Value(), "Value mismatch")
+}
+
+func TestAllTrueAnyTrue(t *testing.T) {
+	present := func(v bool) Bool {
+		var b Bool
+		b.Set(v)
+		return b
+	}
+	var unknown Bool
+
+	tests := []struct {
+		name string
+		vals []Bool
+		all  Bool
+		any  Bool
+	}{
+		{name: "empty", vals: nil, all: present(true), any: present(false)},
+		{name: "all true", vals: []Bool{present(true), present(true)}, all: present(true), any: present(true)},
+		{name: "all false", vals: []Bool{present(false), present(false)}, all: present(false), any: present(false)},
+		{name: "mixed", vals: []Bool{present(true), present(false)}, all: present(false), any: present(true)},
+		{name: "true and unknown", vals: []Bool{present(true), unknown}, all: unknown, any: present(true)},
+		{name: "false and unknown", vals: []Bool{unknown, present(false)}, all: present(false), any: unknown},
+		{name: "only unknown", vals: []Bool{unknown}, all: unknown, any: unknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allTrue := AllTrue(tt.vals...)
+			require.Equal(t, tt.all.Present(), allTrue.Present(), "AllTrue Present mismatch")
+			require.Equal(t, tt.all.Value(), allTrue.Value(), "AllTrue Value mismatch")
+
+			anyTrue := AnyTrue(tt.vals...)
+			require.Equal(t, tt.any.Present(), anyTrue.Present(), "AnyTrue Present mismatch")
+			require.Equal(t, tt.any.Value(), anyTrue.Value(), "AnyTrue Value mismatch")
+		})
+	}
+}
+
+func TestBool_MustValue(t *testing.T) {
+	var b Bool
+	require.Panics(t, func() { b.MustValue() }, "MustValue should panic when absent")
+
+	b.Set(true)
+	require.True(t, b.MustValue(), "MustValue mismatch")
+}
+
+func TestBool_ValueOr(t *testing.T) {
+	var b Bool
+	require.True(t, b.ValueOr(true), "absent Bool should return the default")
+	require.False(t, b.ValueOr(false), "absent Bool should return the default")
+
+	b.Set(false)
+	require.False(t, b.ValueOr(true), "present false should win over the default")
+	b.Set(true)
+	require.True(t, b.ValueOr(false), "present true should win over the default")
+}
+
+func TestBool_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		value   bool
+		present bool
+		wantErr bool
+	}{
+		{name: "nil", src: nil},
+		{name: "bool true", src: true, value: true, present: true},
+		{name: "bool false", src: false, present: true},
+		{name: "int64 one", src: int64(1), value: true, present: true},
+		{name: "int64 nonzero", src: int64(-3), value: true, present: true},
+		{name: "int64 zero", src: int64(0), present: true},
+		{name: "postgres t", src: []byte("t"), value: true, present: true},
+		{name: "postgres f", src: []byte("f"), present: true},
+		{name: "string true", src: "true", value: true, present: true},
+		{name: "string false", src: "false", present: true},
+		{name: "string 1", src: "1", value: true, present: true},
+		{name: "bytes 0", src: []byte("0"), present: true},
+		{name: "invalid text", src: "yes", wantErr: true},
+		{name: "unsupported type", src: 1.5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Bool
+			b.Set(true) // Scan must reset previous state
+			err := b.Scan(tt.src)
+			if tt.wantErr {
+				require.Error(t, err, "Scan should return an error")
+				require.False(t, b.Present(), "Bool should not be present")
+				return
+			}
+			require.NoError(t, err, "Scan should not return an error")
+			require.Equal(t, tt.value, b.Value(), "Value mismatch")
+			require.Equal(t, tt.present, b.Present(), "Present mismatch")
+		})
+	}
+}
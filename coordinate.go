@@ -0,0 +1,135 @@
+package params
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Coordinate is a geographic point with latitude and longitude in degrees.
+type Coordinate struct {
+	lat     float64 // Lat holds the latitude in the range [-90, 90]
+	lng     float64 // Lng holds the longitude in the range [-180, 180]
+	present bool    // Present indicates if the coordinate is present or not
+}
+
+// UnmarshalJSON implements custom unmarshalling for the Coordinate type.
+// It accepts either an object {"lat":..,"lng":..} or an array [lat,lng].
+// Latitude must be within [-90, 90] and longitude within [-180, 180].
+// Both components are required, so a missing or null lat or lng is rejected in either form.
+// If the value is null, it sets Present to false.
+//
+// Parameters:
+//   - data: The JSON data to unmarshal into the Coordinate type.
+//
+// Returns:
+//   - error: An error if the unmarshalling or validation fails, otherwise nil.
+func (c *Coordinate) UnmarshalJSON(data []byte) error {
+	c.lat = 0
+	c.lng = 0
+	c.present = false
+
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	var lat, lng float64
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var pair []*float64
+		if err := json.Unmarshal(data, &pair); err != nil {
+			return err
+		}
+		if len(pair) != 2 {
+			return fmt.Errorf("invalid coordinate: expected [lat,lng], got %d elements", len(pair))
+		}
+		if pair[0] == nil || pair[1] == nil {
+			return fmt.Errorf("invalid coordinate: both lat and lng are required: %s", string(data))
+		}
+		lat, lng = *pair[0], *pair[1]
+	} else {
+		var obj struct {
+			Lat *float64 `json:"lat"`
+			Lng *float64 `json:"lng"`
+		}
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return err
+		}
+		if obj.Lat == nil || obj.Lng == nil {
+			return fmt.Errorf("invalid coordinate: both lat and lng are required: %s", string(data))
+		}
+		lat, lng = *obj.Lat, *obj.Lng
+	}
+
+	return c.Set(lat, lng)
+}
+
+// MarshalJSON implements custom marshalling for the Coordinate type.
+// It emits an object {"lat":..,"lng":..}, or null if the coordinate is not present.
+//
+// Returns:
+//   - []byte: The JSON representation of the Coordinate type.
+//   - error: An error if the marshalling fails, otherwise nil.
+func (c Coordinate) MarshalJSON() ([]byte, error) {
+	if !c.present {
+		return []byte("null"), nil
+	}
+	return json.Marshal(struct {
+		Lat float64 `json:"lat"`
+		Lng float64 `json:"lng"`
+	}{Lat: c.lat, Lng: c.lng})
+}
+
+// Set sets the latitude and longitude and marks the coordinate as present.
+// The value is left unchanged if validation fails.
+//
+// Parameters:
+//   - lat: The latitude in the range [-90, 90].
+//   - lng: The longitude in the range [-180, 180].
+//
+// Returns:
+//   - error: An error if either component is out of range, otherwise nil.
+func (c *Coordinate) Set(lat, lng float64) error {
+	if !(lat >= -90 && lat <= 90) {
+		return fmt.Errorf("latitude out of range [-90, 90]: %v", lat)
+	}
+	if !(lng >= -180 && lng <= 180) {
+		return fmt.Errorf("longitude out of range [-180, 180]: %v", lng)
+	}
+	c.lat = lat
+	c.lng = lng
+	c.present = true
+	return nil
+}
+
+// Lat retrieves the latitude of the Coordinate type.
+// If the coordinate is not present, it returns zero.
+//
+// Returns:
+//   - float64: The latitude if present, otherwise zero.
+func (c *Coordinate) Lat() float64 {
+	if !c.present {
+		return 0
+	}
+	return c.lat
+}
+
+// Lng retrieves the longitude of the Coordinate type.
+// If the coordinate is not present, it returns zero.
+//
+// Returns:
+//   - float64: The longitude if present, otherwise zero.
+func (c *Coordinate) Lng() float64 {
+	if !c.present {
+		return 0
+	}
+	return c.lng
+}
+
+// Present checks if the Coordinate type is present in the JSON payload.
+// It returns true if the coordinate was provided in the JSON payload, otherwise false.
+//
+// Returns:
+//   - bool: True if the coordinate is present, otherwise false.
+func (c *Coordinate) Present() bool {
+	return c.present
+}
@@ -0,0 +1,63 @@
+package params
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoordinate(t *testing.T) {
+	type result struct {
+		Location Coordinate `json:"location"`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		output  string
+		lat     float64
+		lng     float64
+		present bool
+		wantErr bool
+	}{
+		{name: "object", input: `{"location":{"lat":52.52,"lng":13.405}}`, lat: 52.52, lng: 13.405, present: true},
+		{name: "array", input: `{"location":[-33.8688,151.2093]}`, output: `{"location":{"lat":-33.8688,"lng":151.2093}}`, lat: -33.8688, lng: 151.2093, present: true},
+		{name: "boundaries", input: `{"location":{"lat":-90,"lng":180}}`, lat: -90, lng: 180, present: true},
+		{name: "origin", input: `{"location":{"lat":0,"lng":0}}`, present: true},
+		{name: "null", input: `{"location":null}`},
+		{name: "missing", input: `{}`, output: `{"location":null}`},
+		{name: "latitude out of range", input: `{"location":{"lat":90.1,"lng":0}}`, wantErr: true},
+		{name: "longitude out of range", input: `{"location":[0,-180.5]}`, wantErr: true},
+		{name: "missing lng", input: `{"location":{"lat":10}}`, wantErr: true},
+		{name: "short array", input: `{"location":[10]}`, wantErr: true},
+		{name: "null latitude in array", input: `{"location":[null,1]}`, wantErr: true},
+		{name: "null longitude in array", input: `{"location":[1,null]}`, wantErr: true},
+		{name: "null latitude in object", input: `{"location":{"lat":null,"lng":1}}`, wantErr: true},
+		{name: "long array", input: `{"location":[10,20,30]}`, wantErr: true},
+		{name: "string", input: `{"location":"10,20"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.output == "" {
+				tt.output = tt.input
+			}
+			var test result
+			err := json.Unmarshal([]byte(tt.input), &test)
+			if tt.wantErr {
+				require.Error(t, err, "Unmarshal should return an error")
+				require.False(t, test.Location.Present(), "Coordinate should not be present")
+				return
+			}
+			require.NoError(t, err, "Unmarshal should not return an error")
+			require.Equal(t, tt.lat, test.Location.Lat(), "Lat mismatch")
+			require.Equal(t, tt.lng, test.Location.Lng(), "Lng mismatch")
+			require.Equal(t, tt.present, test.Location.Present(), "Present mismatch")
+
+			js, err := json.Marshal(test)
+			require.NoError(t, err, "Marshal should not return an error")
+			require.JSONEq(t, tt.output, string(js), "Marshalled JSON mismatch")
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package params
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// defaultCSVDelimiter is used when no delimiter has been configured.
+const defaultCSVDelimiter = ","
+
+// CSV is a list of strings transferred as a single delimiter-separated string,
+// e.g. "red, green,blue". Unlike a JSON array it expects a scalar string on the wire.
+type CSV struct {
+	value     []string // Value holds the parsed items
+	present   bool     // Present indicates if the list is present or not
+	delimiter string   // Delimiter separates the items, comma if empty
+}
+
+// UnmarshalJSON implements custom unmarshalling for the CSV type.
+// It expects a JSON string and splits it on the configured delimiter,
+// trimming whitespace around each item and dropping empty items.
+// If the value is null or a blank string, it sets Present to false.
+//
+// Parameters:
+//   - data: The JSON data to unmarshal into the CSV type.
+//
+// Returns:
+//   - error: An error if the unmarshalling fails, otherwise nil.
+func (c *CSV) UnmarshalJSON(data []byte) error {
+	c.value = nil
+	c.present = false
+
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	c.parse(str)
+
+	return nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The text is split as is, without expecting JSON quotes.
+//
+// Parameters:
+//   - text: The text data to unmarshal into the CSV type.
+//
+// Returns:
+//   - error: An error if the unmarshalling fails, otherwise nil.
+func (c *CSV) UnmarshalText(text []byte) error {
+	return c.UnmarshalParam(string(text))
+}
+
+// UnmarshalParam is a helper method to unmarshal a string parameter directly.
+// The parameter is split as is, without expecting JSON quotes.
+//
+// Parameters:
+//   - param: The string parameter to unmarshal into the CSV type.
+//
+// Returns:
+//   - error: An error if the unmarshalling fails, otherwise nil.
+func (c *CSV) UnmarshalParam(param string) error {
+	c.value = nil
+	c.present = false
+	c.parse(param)
+	return nil
+}
+
+// MarshalJSON implements custom marshalling for the CSV type.
+// It joins the items with the configured delimiter into a JSON string.
+// If the list is not present, it returns null.
+//
+// Returns:
+//   - []byte: The JSON representation of the CSV type.
+//   - error: An error if the marshalling fails, otherwise nil.
+func (c CSV) MarshalJSON() ([]byte, error) {
+	if !c.present {
+		return []byte("null"), nil
+	}
+	return json.Marshal(strings.Join(c.value, c.sep()))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It joins the items with the configured delimiter, or returns empty bytes if the list is not present.
+//
+// Returns:
+//   - []byte: The text representation of the CSV type.
+//   - error: An error if the marshalling fails, otherwise nil.
+func (c CSV) MarshalText() ([]byte, error) {
+	if !c.present {
+		return []byte{}, nil
+	}
+	return []byte(strings.Join(c.value, c.sep())), nil
+}
+
+// SetDelimiter sets the delimiter used to split and join items.
+// An empty delimiter restores the default comma.
+//
+// Parameters:
+//   - delimiter: The item delimiter.
+func (c *CSV) SetDelimiter(delimiter string) {
+	c.delimiter = delimiter
+}
+
+// Set sets the items of the CSV type and marks it as present.
+//
+// Parameters:
+//   - value: The items to set for the CSV type.
+func (c *CSV) Set(value []string) {
+	c.value = value
+	c.present = true
+}
+
+// Value retrieves the items of the CSV type.
+// If the list is not present, it returns nil.
+//
+// Returns:
+//   - []string: The items if present, otherwise nil.
+func (c *CSV) Value() []string {
+	if !c.present {
+		return nil
+	}
+	return c.value
+}
+
+// MustValue is like Value but panics if the list is not present.
+// Like Int.MustValue, it is meant for tests, not for request-handling code.
+//
+// Returns:
+//   - []string: The value of the CSV type.
+func (c *CSV) MustValue() []string {
+	if !c.present {
+		panic("params: MustValue called on an absent CSV")
+	}
+	return c.value
+}
+
+// Present checks if the CSV type is present in the payload.
+// It returns true if a non-blank list was provided, otherwise false.
+//
+// Returns:
+//   - bool: True if the list is present, otherwise false.
+func (c *CSV) Present() bool {
+	return c.present
+}
+
+// parse splits str into trimmed, non-empty items and marks the list present if any remain.
+func (c *CSV) parse(str string) {
+	if strings.TrimSpace(str) == "" {
+		return
+	}
+
+	items := strings.Split(str, c.sep())
+	c.value = make([]string, 0, len(items))
+	for _, item := range items {
+		if item = strings.TrimSpace(item); item != "" {
+			c.value = append(c.value, item)
+		}
+	}
+	c.present = true
+}
+
+// sep returns the configured delimiter or the default comma.
+func (c *CSV) sep() string {
+	if c.delimiter == "" {
+		return defaultCSVDelimiter
+	}
+	return c.delimiter
+}
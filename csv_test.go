@@ -0,0 +1,74 @@
+package params
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSV(t *testing.T) {
+	type result struct {
+		Tags CSV `json:"tags"`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		output  string
+		value   []string
+		present bool
+		wantErr bool
+	}{
+		{name: "simple list", input: `{"tags":"a,b,c"}`, value: []string{"a", "b", "c"}, present: true},
+		{name: "whitespace trimmed", input: `{"tags":" a , b ,c "}`, output: `{"tags":"a,b,c"}`, value: []string{"a", "b", "c"}, present: true},
+		{name: "empty items dropped", input: `{"tags":"a,,b,"}`, output: `{"tags":"a,b"}`, value: []string{"a", "b"}, present: true},
+		{name: "single item", input: `{"tags":"one"}`, value: []string{"one"}, present: true},
+		{name: "empty string", input: `{"tags":""}`, output: `{"tags":null}`},
+		{name: "blank string", input: `{"tags":"   "}`, output: `{"tags":null}`},
+		{name: "null", input: `{"tags":null}`},
+		{name: "missing", input: `{}`, output: `{"tags":null}`},
+		{name: "array rejected", input: `{"tags":["a","b"]}`, wantErr: true},
+		{name: "number rejected", input: `{"tags":1}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.output == "" {
+				tt.output = tt.input
+			}
+			var test result
+			err := json.Unmarshal([]byte(tt.input), &test)
+			if tt.wantErr {
+				require.Error(t, err, "Unmarshal should return an error")
+				return
+			}
+			require.NoError(t, err, "Unmarshal should not return an error")
+			require.Equal(t, tt.value, test.Tags.Value(), "Value mismatch")
+			require.Equal(t, tt.present, test.Tags.Present(), "Present mismatch")
+
+			js, err := json.Marshal(test)
+			require.NoError(t, err, "Marshal should not return an error")
+			require.JSONEq(t, tt.output, string(js), "Marshalled JSON mismatch")
+		})
+	}
+}
+
+func TestCSV_SetDelimiter(t *testing.T) {
+	var c CSV
+	c.SetDelimiter(";")
+	require.NoError(t, c.UnmarshalParam("a; b,c ;d"), "UnmarshalParam should not return an error")
+	require.Equal(t, []string{"a", "b,c", "d"}, c.Value(), "Value mismatch")
+
+	text, err := c.MarshalText()
+	require.NoError(t, err, "MarshalText should not return an error")
+	require.Equal(t, "a;b,c;d", string(text), "MarshalText mismatch")
+}
+
+func TestCSV_MustValue(t *testing.T) {
+	var c CSV
+	require.Panics(t, func() { c.MustValue() }, "MustValue should panic when absent")
+
+	require.NoError(t, c.UnmarshalParam("a,b"), "UnmarshalParam should not return an error")
+	require.Equal(t, []string{"a", "b"}, c.MustValue(), "MustValue mismatch")
+}
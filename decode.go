@@ -0,0 +1,179 @@
+package params
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// StrictDecode decodes JSON data into dst rejecting unknown object keys.
+// It uses a json.Decoder with DisallowUnknownFields enabled, so a payload
+// containing keys that do not map to a field of dst returns an error.
+// Fields of params types keep their usual present tracking.
+//
+// Parameters:
+//   - data: The JSON data to decode.
+//   - dst: A pointer to the value to decode into.
+//
+// Returns:
+//   - error: An error if the data is invalid, contains unknown fields or trailing data, otherwise nil.
+func StrictDecode(data []byte, dst any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(dst); err != nil {
+		return err
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return fmt.Errorf("unexpected data after JSON value")
+	}
+
+	return nil
+}
+
+// Decode decodes JSON data into dst after configuring its fields from `params` struct tags.
+// A tag holds comma-separated key=value options; the supported options are:
+//
+//	base=N  parse an Int field in base N, from 2 to 36 (0 for the default), as Int.SetBase does
+//
+// For example a field tagged `json:"flags" params:"base=16"` decodes "ff" as 255.
+// Tags are applied to the exported fields of dst and of its nested structs
+// before the data is decoded with json.Unmarshal. Fields behind pointers,
+// slices or maps are not configured, as json.Unmarshal allocates them anew.
+//
+// Parameters:
+//   - data: The JSON data to decode.
+//   - dst: A non-nil pointer to the value to decode into.
+//
+// Returns:
+//   - error: An error if a tag is invalid or the data cannot be decoded, otherwise nil.
+func Decode(data []byte, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return fmt.Errorf("cannot decode into %T: non-nil pointer expected", dst)
+	}
+
+	if v.Elem().Kind() == reflect.Struct {
+		if err := applyTags(v.Elem()); err != nil {
+			return err
+		}
+	}
+
+	return json.Unmarshal(data, dst)
+}
+
+// applyTags configures the fields of the addressable struct v from their `params` tags.
+func applyTags(v reflect.Value) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("params")
+		if tag == "" {
+			if field.Type.Kind() == reflect.Struct {
+				if err := applyTags(v.Field(i)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		for _, option := range strings.Split(tag, ",") {
+			key, value, _ := strings.Cut(option, "=")
+			switch key {
+			case "base":
+				dst, ok := v.Field(i).Addr().Interface().(*Int)
+				if !ok {
+					return fmt.Errorf("field %s of type %s does not support the base option", field.Name, field.Type)
+				}
+				base, err := strconv.Atoi(value)
+				if err != nil || base != 0 && (base < 2 || base > 36) {
+					return fmt.Errorf("field %s: invalid base %q", field.Name, value)
+				}
+				dst.SetBase(base)
+			default:
+				return fmt.Errorf("field %s: unknown params option %q", field.Name, option)
+			}
+		}
+	}
+
+	return nil
+}
+
+// DetectDuplicateKeys scans JSON data and reports object keys that appear more than once.
+// encoding/json silently keeps the last occurrence of a duplicated key, so this
+// helper can be run before decoding to surface malformed payloads.
+// Keys are reported once each, in order of their second occurrence, as dotted
+// paths with array indexes, e.g. "user.name" or "items[1].id".
+//
+// Parameters:
+//   - data: The JSON data to scan.
+//
+// Returns:
+//   - []string: The paths of the duplicated keys, or nil if there are none.
+//   - error: An error if the data is not valid JSON, otherwise nil.
+func DetectDuplicateKeys(data []byte) ([]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var duplicates []string
+	if err := scanDuplicateKeys(decoder, "", &duplicates); err != nil {
+		return nil, err
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+
+	return duplicates, nil
+}
+
+// scanDuplicateKeys consumes the next JSON value from decoder, appending the
+// paths of duplicated keys below path to duplicates.
+func scanDuplicateKeys(decoder *json.Decoder, path string, duplicates *[]string) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		seen := make(map[string]int)
+		for decoder.More() {
+			token, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			key := token.(string)
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+			if seen[key]++; seen[key] == 2 {
+				*duplicates = append(*duplicates, child)
+			}
+			if err := scanDuplicateKeys(decoder, child, duplicates); err != nil {
+				return err
+			}
+		}
+	case json.Delim('['):
+		for idx := 0; decoder.More(); idx++ {
+			if err := scanDuplicateKeys(decoder, fmt.Sprintf("%s[%d]", path, idx), duplicates); err != nil {
+				return err
+			}
+		}
+	default:
+		return nil // Scalar value
+	}
+
+	_, err = decoder.Token() // Closing delimiter
+	return err
+}
@@ -0,0 +1,127 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrictDecode(t *testing.T) {
+	type result struct {
+		Field Int    `json:"field"`
+		Value String `json:"value"`
+	}
+
+	tests := []struct {
+		name         string
+		input        string
+		fieldPresent bool
+		valuePresent bool
+		wantErr      bool
+	}{
+		{name: "all fields", input: `{"field":1,"value":"a"}`, fieldPresent: true, valuePresent: true},
+		{name: "missing field", input: `{"value":"a"}`, fieldPresent: false, valuePresent: true},
+		{name: "null field", input: `{"field":null,"value":"a"}`, fieldPresent: false, valuePresent: true},
+		{name: "empty object", input: `{}`},
+		{name: "unknown field", input: `{"field":1,"feild":2}`, wantErr: true},
+		{name: "trailing data", input: `{"field":1} {"field":2}`, wantErr: true},
+		{name: "trailing brace", input: `{"field":1}}`, wantErr: true},
+		{name: "trailing whitespace", input: "{\"field\":1}\n", fieldPresent: true},
+		{name: "invalid JSON", input: `{"field":1`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst result
+			err := StrictDecode([]byte(tt.input), &dst)
+			if tt.wantErr {
+				require.Error(t, err, "StrictDecode should return an error")
+				return
+			}
+			require.NoError(t, err, "StrictDecode should not return an error")
+			require.Equal(t, tt.fieldPresent, dst.Field.Present(), "Field present mismatch")
+			require.Equal(t, tt.valuePresent, dst.Value.Present(), "Value present mismatch")
+		})
+	}
+}
+
+func TestDetectDuplicateKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []string
+		wantErr bool
+	}{
+		{name: "no duplicates", input: `{"a":1,"b":{"a":2}}`},
+		{name: "top level", input: `{"a":1,"b":2,"a":3}`, want: []string{"a"}},
+		{name: "reported once", input: `{"a":1,"a":2,"a":3}`, want: []string{"a"}},
+		{name: "nested", input: `{"user":{"name":"a","name":"b"}}`, want: []string{"user.name"}},
+		{name: "array elements", input: `{"items":[{"id":1},{"id":2,"id":3}]}`, want: []string{"items[1].id"}},
+		{name: "top level array", input: `[{"a":1,"a":1}]`, want: []string{"[0].a"}},
+		{name: "order of detection", input: `{"b":1,"a":1,"a":2,"b":2}`, want: []string{"a", "b"}},
+		{name: "scalar", input: `42`},
+		{name: "invalid JSON", input: `{"a":1`, wantErr: true},
+		{name: "empty input", input: ``, wantErr: true},
+		{name: "trailing data", input: `{} {}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectDuplicateKeys([]byte(tt.input))
+			if tt.wantErr {
+				require.Error(t, err, "DetectDuplicateKeys should return an error")
+				return
+			}
+			require.NoError(t, err, "DetectDuplicateKeys should not return an error")
+			require.Equal(t, tt.want, got, "duplicate keys mismatch")
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	type Nested struct {
+		ID Int `json:"id" params:"base=36"`
+	}
+	type request struct {
+		Flags  Int `json:"flags" params:"base=16"`
+		Count  Int `json:"count"`
+		Nested Nested
+	}
+
+	var dst request
+	err := Decode([]byte(`{"flags":"ff","count":"10","Nested":{"id":"zz"}}`), &dst)
+	require.NoError(t, err, "Decode should not return an error")
+	require.Equal(t, 255, dst.Flags.Value(), "base 16 field mismatch")
+	require.Equal(t, 10, dst.Count.Value(), "untagged field should stay decimal")
+	require.Equal(t, 1295, dst.Nested.ID.Value(), "nested base 36 field mismatch")
+
+	tests := []struct {
+		name string
+		dst  any
+		data string
+	}{
+		{name: "digit out of base", dst: &struct {
+			Flags Int `json:"flags" params:"base=2"`
+		}{}, data: `{"flags":"12"}`},
+		{name: "invalid base", dst: &struct {
+			Flags Int `json:"flags" params:"base=37"`
+		}{}, data: `{}`},
+		{name: "malformed base", dst: &struct {
+			Flags Int `json:"flags" params:"base"`
+		}{}, data: `{}`},
+		{name: "unsupported type", dst: &struct {
+			Name String `json:"name" params:"base=16"`
+		}{}, data: `{}`},
+		{name: "unknown option", dst: &struct {
+			Flags Int `json:"flags" params:"radix=16"`
+		}{}, data: `{}`},
+		{name: "not a pointer", dst: request{}, data: `{}`},
+		{name: "nil pointer", dst: (*request)(nil), data: `{}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Error(t, Decode([]byte(tt.data), tt.dst), "Decode should return an error")
+		})
+	}
+}
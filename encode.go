@@ -0,0 +1,95 @@
+package params
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// textParam is implemented by params types that have a text form.
+type textParam interface {
+	encoding.TextMarshaler
+	Present() bool
+}
+
+// EncodeValues builds query parameters from a struct of params types.
+// Every exported field tagged with `param:"name"` is encoded using its
+// MarshalText form. Fields that are not present are omitted, fields tagged
+// with `param:"-"` or without a tag are skipped. Untagged embedded structs
+// are encoded as if their fields belonged to the outer struct.
+//
+// Parameters:
+//   - src: A struct or a pointer to a struct holding params types.
+//
+// Returns:
+//   - url.Values: The encoded query parameters.
+//   - error: An error if src is not a struct or a tagged field has no text form, otherwise nil.
+func EncodeValues(src any) (url.Values, error) {
+	v := reflect.ValueOf(src)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, fmt.Errorf("cannot encode nil %s", v.Type())
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot encode %s: struct expected", v.Type())
+	}
+
+	// Params types use pointer receivers, so work on an addressable copy.
+	if !v.CanAddr() {
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		v = c
+	}
+
+	values := url.Values{}
+	if err := encodeValues(values, v); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// encodeValues adds the tagged fields of the addressable struct v to values.
+func encodeValues(values url.Values, v reflect.Value) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, tagged := field.Tag.Lookup("param")
+		name, _, _ := strings.Cut(tag, ",")
+		if !tagged || name == "" {
+			if field.Anonymous && field.Type.Kind() == reflect.Struct {
+				if err := encodeValues(values, v.Field(i)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if name == "-" {
+			continue
+		}
+
+		p, ok := v.Field(i).Addr().Interface().(textParam)
+		if !ok {
+			return fmt.Errorf("field %s of type %s cannot be encoded as a parameter", field.Name, field.Type)
+		}
+		if !p.Present() {
+			continue
+		}
+
+		text, err := p.MarshalText()
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		values.Add(name, string(text))
+	}
+
+	return nil
+}
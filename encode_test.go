@@ -0,0 +1,72 @@
+package params
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeValues(t *testing.T) {
+	type Paging struct {
+		Limit  Int `param:"limit"`
+		Offset Int `param:"offset"`
+	}
+	type request struct {
+		Paging
+		Query    String `param:"q"`
+		Active   Bool   `param:"active"`
+		Since    Time   `param:"since"`
+		Skipped  String `param:"-"`
+		Untagged String
+		hidden   String `param:"hidden"`
+	}
+
+	var src request
+	src.Limit.Set(10)
+	src.Query.Set("go lang")
+	src.Active.Set(false)
+	src.Since.Set(time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC))
+	src.Skipped.Set("skipped")
+	src.Untagged.Set("untagged")
+	src.hidden.Set("hidden")
+
+	want := url.Values{
+		"limit":  {"10"},
+		"q":      {"go lang"},
+		"active": {"false"},
+		"since":  {"2023-10-05T14:48:00Z"},
+	}
+
+	got, err := EncodeValues(src)
+	require.NoError(t, err, "EncodeValues should not return an error")
+	require.Equal(t, want, got, "EncodeValues mismatch for struct value")
+
+	got, err = EncodeValues(&src)
+	require.NoError(t, err, "EncodeValues should not return an error")
+	require.Equal(t, want, got, "EncodeValues mismatch for struct pointer")
+	require.Equal(t, "active=false&limit=10&q=go+lang&since=2023-10-05T14%3A48%3A00Z", got.Encode(), "encoded query mismatch")
+}
+
+func TestEncodeValues_Errors(t *testing.T) {
+	type unsupported struct {
+		Price Money `param:"price"`
+	}
+
+	tests := []struct {
+		name string
+		src  any
+	}{
+		{name: "not a struct", src: 42},
+		{name: "nil pointer", src: (*struct{})(nil)},
+		{name: "field without text form", src: unsupported{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := EncodeValues(tt.src)
+			require.Error(t, err, "EncodeValues should return an error")
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package params
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// OverflowError is returned when a number does not fit into the target integer type.
+// Callers can use errors.As to inspect the offending token, e.g. to build
+// precise 400 responses. It unwraps to strconv.ErrRange.
+type OverflowError struct {
+	Value   string // Value holds the raw number token that overflowed
+	BitSize int    // BitSize is the size in bits of the target integer type
+}
+
+// Error implements the error interface.
+//
+// Returns:
+//   - string: A message naming the offending value and the target size.
+func (e *OverflowError) Error() string {
+	return fmt.Sprintf("number %s overflows int%d", e.Value, e.BitSize)
+}
+
+// Unwrap returns strconv.ErrRange, so errors.Is(err, strconv.ErrRange) keeps working.
+//
+// Returns:
+//   - error: Always strconv.ErrRange.
+func (e *OverflowError) Unwrap() error {
+	return strconv.ErrRange
+}
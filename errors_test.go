@@ -0,0 +1,44 @@
+package params
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverflowError(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		value string
+	}{
+		{name: "bare overflow", input: `{"field":9223372036854775808}`, value: "9223372036854775808"},
+		{name: "quoted underflow", input: `{"field":"-9223372036854775809"}`, value: "-9223372036854775809"},
+		{name: "float overflow", input: `{"field":1e20}`, value: "1e20"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var test struct {
+				Field Int `json:"field"`
+			}
+			err := json.Unmarshal([]byte(tt.input), &test)
+			require.Error(t, err, "Unmarshal should return an error")
+
+			var overflow *OverflowError
+			require.True(t, errors.As(err, &overflow), "error should be an OverflowError: %v", err)
+			require.Equal(t, tt.value, overflow.Value, "Value mismatch")
+			require.Equal(t, strconv.IntSize, overflow.BitSize, "BitSize mismatch")
+			require.ErrorIs(t, err, strconv.ErrRange, "OverflowError should unwrap to strconv.ErrRange")
+			require.Equal(t, "number "+tt.value+" overflows int"+strconv.Itoa(strconv.IntSize), overflow.Error(), "Error mismatch")
+		})
+	}
+
+	var i Int
+	err := i.UnmarshalJSON([]byte(`"abc"`))
+	var overflow *OverflowError
+	require.False(t, errors.As(err, &overflow), "syntax errors should not be an OverflowError")
+}
@@ -0,0 +1,26 @@
+package params
+
+// FNV-1a 64-bit parameters.
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// hashValue returns the 64-bit FNV-1a hash of a presence marker followed by value.
+// The marker byte is 0x00 for absent values (value is ignored) and 0x01 for
+// present ones, so an absent value never hashes like a present empty one.
+func hashValue(present bool, value []byte) uint64 {
+	h := uint64(fnvOffset64)
+	if !present {
+		h ^= 0x00
+		return h * fnvPrime64
+	}
+
+	h ^= 0x01
+	h *= fnvPrime64
+	for _, b := range value {
+		h ^= uint64(b)
+		h *= fnvPrime64
+	}
+	return h
+}
@@ -1,57 +1,279 @@
 package params
 
 import (
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"strconv"
 	"strings"
+	"unicode"
+)
+
+// maxSafeInteger is the largest integer a float64 represents exactly, Number.MAX_SAFE_INTEGER in JavaScript.
+const maxSafeInteger = 1<<53 - 1
+
+// LargeNumberPolicy selects how Int handles numbers that do not fit into int.
+type LargeNumberPolicy int
+
+const (
+	// LargeNumberError rejects out-of-range numbers with an *OverflowError. It is the default.
+	LargeNumberError LargeNumberPolicy = iota
+	// LargeNumberSaturate clamps out-of-range numbers to math.MaxInt or math.MinInt.
+	LargeNumberSaturate
+	// LargeNumberTruncate keeps the low-order bits of out-of-range integers,
+	// wrapping around as a two's complement conversion does. Numbers written
+	// with a fraction or exponent, such as 1e30, are still rejected.
+	LargeNumberTruncate
 )
 
 type Int struct {
-	value   int  // Value holds the actual integer value
-	present bool // Present indicates if the integer is present or not
+	value      int               // Value holds the actual integer value
+	present    bool              // Present indicates if the integer is present or not
+	policy     LargeNumberPolicy // Policy selects how numbers that do not fit into int are handled
+	strict     bool              // Strict rejects quoted numbers padded with whitespace
+	base       int               // Base parses numbers in the given base instead of decimal when non-zero
+	boolean    bool              // Boolean maps JSON true and false to 1 and 0
+	jsSafe     bool              // JSSafe quotes values beyond the JavaScript safe integer range
+	def        int               // Def is the value UnmarshalParam uses for an empty parameter
+	hasDef     bool              // HasDef indicates if a default was set with SetDefault
+	keepRaw    bool              // KeepRaw records the raw JSON token for pass-through marshalling
+	raw        string            // Raw holds the JSON token the value was decoded from if KeepRaw is enabled
+	zeroAbsent bool              // ZeroAbsent treats a decoded zero as absent
+	grouped    bool              // Grouped accepts numbers with thousands separators
+	groupSep   string            // GroupSep is the thousands separator, empty for the default ","
+	absentText string            // AbsentText is what AppendTo appends for an absent integer
 }
 
 // UnmarshalJSON implements custom unmarshalling for the Int type.
 // It handles cases where the integer may be zero, null, or quoted.
-// If the integer is zero or null, it sets Present to false and Value to zero.
+// If the integer is null, it sets Present to false and Value to zero.
+// A zero is present like any other number unless SetZeroAsAbsent is enabled.
 // If the integer is quoted, it removes the quotes and sets Present to true.
 // If the integer is not quoted, it sets Present to true and retains the value as is.
+// Whitespace around a quoted integer, such as " 123 ", is trimmed unless strict mode is enabled.
+// Numbers with a zero fractional part, such as 123.0 or 1e3, are accepted,
+// while numbers like 123.4 are rejected.
+// If a base was set with SetBase, the number is parsed in that base instead.
+// JSON true and false are rejected unless SetAcceptBool is enabled.
+// Integers with more digits than any int has are rejected with an *OverflowError
+// before decoding, unless a large-number policy other than LargeNumberError is set.
+// Quoted numbers with thousands separators, such as "1,234", are rejected unless SetAllowThousandsSep is enabled.
+// With SetKeepRaw enabled, the raw token is recorded for pass-through marshalling.
 // This allows for flexible handling of integer values in JSON payloads.
 func (i *Int) UnmarshalJSON(data []byte) error {
-	if len(data) == 0 || string(data) == "null" {
-		i.value = 0
+	i.raw = ""
+	if err := i.decodeJSON(data); err != nil || !i.present {
+		return err
+	}
+	if i.zeroAbsent && i.value == 0 {
 		i.present = false
 		return nil
 	}
+	if i.keepRaw {
+		i.raw = string(data)
+	}
+	return nil
+}
 
-	decoder := json.NewDecoder(strings.NewReader(string(data)))
-	decoder.UseNumber()
+// decodeJSON implements UnmarshalJSON without recording the raw token.
+func (i *Int) decodeJSON(data []byte) error {
+	i.value = 0
+	i.present = false
 
-	//err := json.Unmarshal(data, &alt)
-	//if err != nil {
-	//return err
-	//}
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
 
-	var v json.Number
+	if i.boolean {
+		switch string(data) {
+		case "true":
+			i.value, i.present = 1, true
+			return nil
+		case "false":
+			i.present = true
+			return nil
+		}
+	}
 
-	if err := json.Unmarshal(data, &v); err != nil {
-		i.value = 0
-		i.present = false
-		return err
-	} else {
-		vv, err := v.Int64()
+	token := data
+	if data[0] == '"' {
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+		if i.strict && strings.ContainsFunc(str, unicode.IsSpace) {
+			return fmt.Errorf("quoted number contains whitespace: %s", string(data))
+		}
+		trimmed := strings.TrimSpace(str)
+		if trimmed == "" || trimmed[0] == '"' {
+			return fmt.Errorf("invalid number format: %s", string(data))
+		}
+		if i.grouped {
+			var ok bool
+			if trimmed, ok = i.ungroup(trimmed); !ok {
+				return fmt.Errorf("invalid digit grouping: %s", string(data))
+			}
+		}
+		token = []byte(trimmed)
+	}
+
+	if i.base != 0 {
+		vv, err := strconv.ParseInt(string(token), i.base, 64)
+		value, err := i.fit(string(token), i.base, vv, err)
 		if err != nil {
-			i.value = 0
-			i.present = false
 			return err
 		}
-		i.value = int(vv)
+		i.value = value
+		i.present = true
+		return nil
+	}
+
+	if i.policy == LargeNumberError && isLongInteger(token) {
+		// Reject oversized digit strings before decoding and parsing them.
+		return &OverflowError{Value: string(token), BitSize: strconv.IntSize}
+	}
+
+	var v json.Number
+	if err := json.Unmarshal(token, &v); err != nil {
+		return err
 	}
+
+	value, err := i.parse(v.String())
+	if err != nil {
+		return err
+	}
+	i.value = value
 	i.present = true
 
 	return nil
 }
 
+// maxIntDigits is the number of decimal digits of math.MaxInt.
+var maxIntDigits = len(strconv.Itoa(math.MaxInt))
+
+// isLongInteger reports whether token is a JSON integer literal with more digits than any int has.
+func isLongInteger(token []byte) bool {
+	if len(token) > 0 && token[0] == '-' {
+		token = token[1:]
+	}
+	if len(token) <= maxIntDigits || token[0] == '0' {
+		return false // Leading zeros are a syntax error, not an overflow
+	}
+	for _, c := range token {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// parse converts a JSON number literal into an int honoring the overflow options.
+func (i *Int) parse(s string) (int, error) {
+	vv, err := strconv.ParseInt(s, 10, 64)
+	if errors.Is(err, strconv.ErrSyntax) {
+		// Accept integral numbers written as decimals or with an exponent.
+		if digits, ok := integralDigits(s); ok {
+			vv, err = strconv.ParseInt(digits, 10, 64)
+		}
+	}
+	return i.fit(s, 10, vv, err)
+}
+
+// integralDigits rewrites the JSON number literal s with a fraction or an exponent,
+// such as "123.0" or "1.5e3", as plain decimal digits working on the text, so no
+// precision is lost as with float64. It reports false if s is not integral.
+// Exponents are capped at maxIntDigits+2 zeros, which is enough to overflow any
+// int while keeping the digits short for literals like 1e1000000.
+func integralDigits(s string) (string, bool) {
+	sign := ""
+	if s != "" && s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+
+	mantissa, exponent, hasExp := strings.Cut(s, "e")
+	if !hasExp {
+		mantissa, exponent, hasExp = strings.Cut(s, "E")
+	}
+	whole, frac, _ := strings.Cut(mantissa, ".")
+
+	exp := 0
+	if hasExp {
+		negative := exponent != "" && exponent[0] == '-'
+		exponent = strings.TrimLeft(exponent, "+-")
+		if exponent == "" {
+			return "", false
+		}
+		for _, c := range exponent {
+			if c < '0' || c > '9' {
+				return "", false
+			}
+			if exp < 1<<20 {
+				exp = exp*10 + int(c-'0')
+			}
+		}
+		if negative {
+			exp = -exp
+		}
+	}
+
+	digits := strings.TrimLeft(whole+frac, "0")
+	if digits == "" {
+		return "0", true
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return "", false
+		}
+	}
+	exp -= len(frac)
+	trimmed := strings.TrimRight(digits, "0")
+	exp += len(digits) - len(trimmed)
+	if exp < 0 {
+		return "", false // Nonzero fractional digits remain
+	}
+
+	return sign + trimmed + strings.Repeat("0", min(exp, maxIntDigits+2)), true
+}
+
+// fit narrows the result of strconv.ParseInt for token s in base into an int honoring the overflow policy.
+func (i *Int) fit(s string, base int, vv int64, err error) (int, error) {
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return 0, err
+	}
+	if err == nil && vv >= math.MinInt && vv <= math.MaxInt {
+		return int(vv), nil
+	}
+
+	// On overflow vv holds the nearest int64 bound, so its sign tells the direction.
+	switch i.policy {
+	case LargeNumberSaturate:
+		if vv > 0 {
+			return math.MaxInt, nil
+		}
+		return math.MinInt, nil
+	case LargeNumberTruncate:
+		if v, ok := truncateInt(s, base); ok {
+			return v, nil
+		}
+	}
+
+	return 0, &OverflowError{Value: s, BitSize: strconv.IntSize}
+}
+
+// truncateInt parses the integer literal s in base and keeps the low-order bits
+// that fit into int, as a two's complement conversion does.
+func truncateInt(s string, base int) (int, bool) {
+	x, ok := new(big.Int).SetString(s, base)
+	if !ok {
+		return 0, false
+	}
+	low := new(big.Int).And(x, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+	return int(int64(low)), true
+}
+
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
 // It allows the Int type to be unmarshalled from text representations.
 // This method simply calls UnmarshalJSON with the provided text data.
@@ -66,7 +288,16 @@ func (i *Int) UnmarshalText(text []byte) error {
 }
 
 // UnmarshalParam is a helper method to unmarshal a string parameter directly.
-// It converts the string parameter to a byte slice and calls UnmarshalJSON.
+// Unquoted parameters are parsed with strconv.ParseInt without allocating.
+// Explicit base prefixes are detected: "0x1F" is hexadecimal, "0b101" binary
+// and "0o17" octal, and underscores may separate their digits. Everything else
+// is decimal, so a zero-padded "0123" is read as 123, not as octal.
+// UnmarshalJSON stays decimal-only as required by JSON.
+// If a base was set with SetBase, unquoted parameters are parsed in that base instead.
+// With SetAllowThousandsSep enabled, digit groups such as "1,234" are accepted.
+// An empty parameter yields the default set with SetDefault, if any.
+// A zero is treated as absent if SetZeroAsAbsent is enabled.
+// Anything else (quoted values, null, empty) falls back to UnmarshalJSON.
 //
 // Parameters:
 //   - param: The string parameter to unmarshal into the Int type.
@@ -74,9 +305,228 @@ func (i *Int) UnmarshalText(text []byte) error {
 // Returns:
 //   - error: An error if the unmarshalling fails, otherwise nil.
 func (i *Int) UnmarshalParam(param string) error {
+	if param == "" && i.hasDef {
+		i.Set(i.def)
+		return nil
+	}
+	if i.grouped {
+		ungrouped, ok := i.ungroup(param)
+		if !ok {
+			i.value = 0
+			i.present = false
+			return fmt.Errorf("invalid digit grouping: %s", param)
+		}
+		param = ungrouped
+	}
+	base := i.base
+	if base == 0 {
+		base = paramBase(param)
+	}
+	if vv, err := strconv.ParseInt(param, base, 64); err == nil || errors.Is(err, strconv.ErrRange) {
+		value, err := i.fit(param, base, vv, err)
+		i.raw = "" // Parameters are not JSON, so they are never passed through
+		if err != nil {
+			i.value = 0
+			i.present = false
+			return err
+		}
+		i.value = value
+		i.present = !i.zeroAbsent || value != 0
+		return nil
+	}
 	return i.UnmarshalJSON([]byte(param))
 }
 
+// paramBase returns 0, letting strconv.ParseInt detect the base, if param starts
+// with an explicit 0x, 0b or 0o prefix after an optional sign, and 10 otherwise.
+func paramBase(param string) int {
+	if param != "" && (param[0] == '-' || param[0] == '+') {
+		param = param[1:]
+	}
+	if len(param) < 2 || param[0] != '0' {
+		return 10
+	}
+	switch param[1] {
+	case 'x', 'X', 'b', 'B', 'o', 'O':
+		return 0
+	}
+	return 10
+}
+
+// SetOverflowClamp enables or disables clamping of out-of-range numbers.
+// When enabled, UnmarshalJSON saturates numbers that do not fit into int
+// to math.MaxInt or math.MinInt and marks the value as present instead of
+// returning an error. Clamping is disabled by default.
+// It is shorthand for SetLargeNumberPolicy with LargeNumberSaturate or LargeNumberError.
+//
+// Parameters:
+//   - clamp: True to clamp out-of-range numbers, false to reject them.
+func (i *Int) SetOverflowClamp(clamp bool) {
+	if clamp {
+		i.policy = LargeNumberSaturate
+	} else {
+		i.policy = LargeNumberError
+	}
+}
+
+// SetLargeNumberPolicy selects how UnmarshalJSON and UnmarshalParam handle
+// numbers that do not fit into int. LargeNumberError, the default, returns an
+// *OverflowError, LargeNumberSaturate clamps to the nearest bound and
+// LargeNumberTruncate wraps around. It replaces any policy set with SetOverflowClamp.
+//
+// Parameters:
+//   - policy: The policy to apply.
+func (i *Int) SetLargeNumberPolicy(policy LargeNumberPolicy) {
+	i.policy = policy
+}
+
+// SetStrict enables or disables strict mode.
+// When enabled, UnmarshalJSON rejects quoted numbers containing any whitespace,
+// such as " 123 " or "1 23", with a dedicated error. When disabled (the default),
+// leading and trailing whitespace is trimmed and the number is accepted.
+//
+// Parameters:
+//   - strict: True to reject quoted numbers containing whitespace.
+func (i *Int) SetStrict(strict bool) {
+	i.strict = strict
+}
+
+// SetBase sets the base used to parse numbers, such as 36 for compact IDs.
+// When set, UnmarshalJSON and UnmarshalParam parse the (possibly quoted) number
+// with strconv.ParseInt(s, base, 64), so "zz" reads as 1295 in base 36.
+// Fractions and exponents are not accepted in this mode.
+// A base of 0 restores the default parsing. It panics if base is not 0 or in the range [2, 36].
+//
+// Parameters:
+//   - base: The base in the range [2, 36], or 0 for the default.
+func (i *Int) SetBase(base int) {
+	if base != 0 && (base < 2 || base > 36) {
+		panic(fmt.Sprintf("params: Int.SetBase called with invalid base %d", base))
+	}
+	i.base = base
+}
+
+// SetAllowThousandsSep enables or disables numbers with thousands separators.
+// When enabled, UnmarshalJSON (for quoted numbers) and UnmarshalParam accept
+// digits grouped by the separator, e.g. "1,234,567" or "-1,000", as posted by
+// HTML forms with locale formatting. Groups must have three digits after the
+// first, so "1,23" is rejected. It is disabled by default.
+//
+// Parameters:
+//   - allow: True to accept thousands separators.
+func (i *Int) SetAllowThousandsSep(allow bool) {
+	i.grouped = allow
+}
+
+// SetThousandsSep sets the separator accepted when SetAllowThousandsSep is enabled,
+// e.g. "." or " " for European formats. An empty separator restores the default ",".
+//
+// Parameters:
+//   - sep: The thousands separator.
+func (i *Int) SetThousandsSep(sep string) {
+	i.groupSep = sep
+}
+
+// ungroup removes thousands separators from s, reporting false if the digit groups are malformed.
+func (i *Int) ungroup(s string) (string, bool) {
+	sep := i.groupSep
+	if sep == "" {
+		sep = ","
+	}
+	if !strings.Contains(s, sep) {
+		return s, true
+	}
+
+	sign, digits := "", s
+	if digits != "" && (digits[0] == '-' || digits[0] == '+') {
+		sign, digits = digits[:1], digits[1:]
+	}
+	groups := strings.Split(digits, sep)
+	if len(groups[0]) < 1 || len(groups[0]) > 3 {
+		return s, false
+	}
+	for _, group := range groups[1:] {
+		if len(group) != 3 {
+			return s, false
+		}
+	}
+
+	return sign + strings.Join(groups, ""), true
+}
+
+// SetZeroAsAbsent enables or disables treating zero as absent.
+// When enabled, UnmarshalJSON and UnmarshalParam set Present to false for a
+// decoded 0, for legacy APIs that use 0 to mean "not set". It is disabled by
+// default, so 0 is present like any other number.
+//
+// Parameters:
+//   - zeroAsAbsent: True to treat a decoded zero as absent.
+func (i *Int) SetZeroAsAbsent(zeroAsAbsent bool) {
+	i.zeroAbsent = zeroAsAbsent
+}
+
+// SetAcceptBool enables or disables boolean input.
+// When enabled, UnmarshalJSON maps the JSON literals true and false to 1 and 0
+// and marks the value as present. Quoted "true" and "false" are still rejected.
+// It is disabled by default.
+//
+// Parameters:
+//   - accept: True to accept JSON booleans.
+func (i *Int) SetAcceptBool(accept bool) {
+	i.boolean = accept
+}
+
+// SetJSSafe enables or disables JavaScript-safe output.
+// When enabled, MarshalJSON emits integers whose magnitude exceeds 2^53-1
+// (9007199254740991, Number.MAX_SAFE_INTEGER) as quoted strings, so JavaScript
+// clients do not lose precision, while smaller values stay bare numbers.
+// UnmarshalJSON accepts both forms. It is disabled by default.
+//
+// Parameters:
+//   - jsSafe: True to quote integers beyond the safe range.
+func (i *Int) SetJSSafe(jsSafe bool) {
+	i.jsSafe = jsSafe
+}
+
+// SetDefault sets the value UnmarshalParam uses for an empty parameter, such as
+// "?limit=", so it yields a present Int holding n instead of an absent one.
+// It only affects UnmarshalParam; without a default an empty parameter stays absent.
+//
+// Parameters:
+//   - n: The default value.
+func (i *Int) SetDefault(n int) {
+	i.def = n
+	i.hasDef = true
+}
+
+// SetKeepRaw enables or disables raw pass-through.
+// When enabled, UnmarshalJSON records the JSON token exactly as received, e.g.
+// `"42"` or `1.0e3`, and MarshalJSON re-emits it byte for byte instead of the
+// canonical number, which lets a proxy forward values unchanged. The raw form
+// is dropped when the value is changed with Set or a transform such as Clamp.
+// It is disabled by default, keeping canonical formatting.
+//
+// Parameters:
+//   - keepRaw: True to record and re-emit the raw token.
+func (i *Int) SetKeepRaw(keepRaw bool) {
+	i.keepRaw = keepRaw
+	if !keepRaw {
+		i.raw = ""
+	}
+}
+
+// RawValue returns a copy of the JSON token recorded by UnmarshalJSON when SetKeepRaw
+// is enabled, or nil if no raw form is recorded.
+//
+// Returns:
+//   - []byte: The raw JSON token, or nil.
+func (i *Int) RawValue() []byte {
+	if i.raw == "" {
+		return nil
+	}
+	return []byte(i.raw)
+}
+
 // Set sets the value of the Int type and marks it as present.
 // This method updates the Value field with the provided integer and sets Present to true.
 //
@@ -85,6 +535,7 @@ func (i *Int) UnmarshalParam(param string) error {
 func (i *Int) Set(value int) {
 	i.value = value
 	i.present = true
+	i.raw = ""
 }
 
 // Value retrieves the value of the Int type.
@@ -100,6 +551,19 @@ func (i *Int) Value() int {
 	return i.value
 }
 
+// MustValue retrieves the value of the Int type, panicking if it is not present.
+// It is meant for tests and setup code where absence is a programming error;
+// request-handling code should check Present or use Value instead.
+//
+// Returns:
+//   - int: The value of the Int type.
+func (i *Int) MustValue() int {
+	if !i.present {
+		panic("params: MustValue called on an absent Int")
+	}
+	return i.value
+}
+
 // Present checks if the Int type is present in the JSON payload.
 // It returns true if the integer was provided in the JSON payload, otherwise false.
 //
@@ -111,11 +575,383 @@ func (i *Int) Present() bool {
 
 // MarshalJSON implements custom marshalling for the Int type.
 // It converts the Int type to a JSON integer representation.
-// If the integer is not present, it returns an empty JSON string.
+// If the integer is not present, it returns null, or 0 when the package-wide
+// policy was changed with SetNullWhenAbsent(false).
+// With SetJSSafe enabled, values beyond the JavaScript safe integer range are quoted.
+// With SetKeepRaw enabled, a value decoded by UnmarshalJSON is emitted exactly as received.
 //
 // Returns:
 //   - []byte: The JSON representation of the Int type.
 //   - error: An error if the marshalling fails, otherwise nil.
 func (i Int) MarshalJSON() ([]byte, error) {
+	if !i.present && nullWhenAbsent() {
+		return []byte("null"), nil
+	}
+	if i.raw != "" {
+		return []byte(i.raw), nil
+	}
+	if v := int64(i.value); i.jsSafe && (v > maxSafeInteger || v < -maxSafeInteger) {
+		return fmt.Appendf(nil, `"%d"`, i.value), nil
+	}
 	return fmt.Appendf(nil, "%d", i.Value()), nil // Marshal the integer value
 }
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the decimal form of the integer, or empty bytes if it is not present.
+//
+// Returns:
+//   - []byte: The text representation of the Int type.
+//   - error: An error if the marshalling fails, otherwise nil.
+func (i Int) MarshalText() ([]byte, error) {
+	if !i.present {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, int64(i.value), 10), nil
+}
+
+// AppendTo appends the decimal form of the integer to b and returns the extended buffer,
+// for writing CSV rows or other text output into a shared buffer without allocating per value.
+// An absent integer appends nothing, i.e. an empty CSV cell, unless a text was set with SetAbsentText.
+//
+// Parameters:
+//   - b: The buffer to append to.
+//
+// Returns:
+//   - []byte: The extended buffer.
+func (i Int) AppendTo(b []byte) []byte {
+	if !i.present {
+		return append(b, i.absentText...)
+	}
+	return strconv.AppendInt(b, int64(i.value), 10)
+}
+
+// SetAbsentText sets the text AppendTo appends for an absent integer,
+// e.g. "NULL" or `\N` for bulk loaders that distinguish missing values from empty cells.
+// An empty text restores the default of appending nothing.
+//
+// Parameters:
+//   - text: The text to append for an absent integer.
+func (i *Int) SetAbsentText(text string) {
+	i.absentText = text
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts int64 and bool sources, converting true/false to 1/0, and decimal
+// string and []byte sources as returned by some SQLite and MySQL drivers.
+// A nil source sets Present to false; any other non-nil source sets Present to true.
+//
+// Parameters:
+//   - src: The database value to scan into the Int type.
+//
+// Returns:
+//   - error: An error if the source type is not supported or out of range, otherwise nil.
+func (i *Int) Scan(src any) error {
+	i.value = 0
+	i.present = false
+	i.raw = ""
+
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case int64:
+		if v < math.MinInt || v > math.MaxInt {
+			return &OverflowError{Value: strconv.FormatInt(v, 10), BitSize: strconv.IntSize}
+		}
+		i.value = int(v)
+	case bool:
+		if v {
+			i.value = 1
+		}
+	case string:
+		return i.scanText(v)
+	case []byte:
+		return i.scanText(string(v))
+	default:
+		return fmt.Errorf("unsupported Scan source type for Int: %T", src)
+	}
+	i.present = true
+
+	return nil
+}
+
+// scanText scans a decimal integer from a textual database value.
+func (i *Int) scanText(str string) error {
+	vv, err := strconv.ParseInt(str, 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return &OverflowError{Value: str, BitSize: strconv.IntSize}
+	}
+	if err != nil {
+		return fmt.Errorf("invalid number format: %s", str)
+	}
+	return i.Scan(vv)
+}
+
+// Hash returns a stable 64-bit hash of the Int type for sharding and routing.
+// The hash is FNV-1a 64 over the byte 0x01 followed by the value as a
+// little-endian int64, so it is reproducible across processes and platforms.
+// An absent Int hashes the single byte 0x00 instead.
+//
+// Returns:
+//   - uint64: The hash of the integer.
+func (i *Int) Hash() uint64 {
+	if !i.present {
+		return hashValue(false, nil)
+	}
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(i.value))
+	return hashValue(true, b[:])
+}
+
+// String implements the fmt.Stringer interface.
+// It returns "null" if the integer is not present, otherwise its decimal form.
+//
+// Returns:
+//   - string: The string representation of the Int type.
+func (i Int) String() string {
+	if !i.present {
+		return "null"
+	}
+	return strconv.Itoa(i.value)
+}
+
+// IsNegative reports whether the integer is present and less than zero.
+//
+// Returns:
+//   - bool: True if the integer is present and negative, otherwise false.
+func (i *Int) IsNegative() bool {
+	return i.present && i.value < 0
+}
+
+// IsPositive reports whether the integer is present and greater than zero.
+//
+// Returns:
+//   - bool: True if the integer is present and positive, otherwise false.
+func (i *Int) IsPositive() bool {
+	return i.present && i.value > 0
+}
+
+// Clamp returns a copy of the Int with the value bounded to [lo, hi].
+// The present flag is preserved, so an absent Int stays absent.
+// It panics if lo is greater than hi.
+//
+// Parameters:
+//   - lo: The lower bound.
+//   - hi: The upper bound.
+//
+// Returns:
+//   - Int: A new Int with the bounded value.
+func (i *Int) Clamp(lo, hi int) Int {
+	if lo > hi {
+		panic(fmt.Sprintf("params: Int.Clamp called with lower bound %d greater than upper bound %d", lo, hi))
+	}
+	t := *i
+	if t.present {
+		t.value = max(lo, min(t.value, hi))
+		t.raw = ""
+	}
+	return t
+}
+
+// Ratio returns the integer divided by denom as a float64, e.g. for success/total rates.
+// The second result is false, and the ratio 0, if either Int is absent or denom is zero,
+// so a zero denominator never yields NaN or an infinity.
+//
+// Parameters:
+//   - denom: The denominator.
+//
+// Returns:
+//   - float64: The ratio if valid, otherwise 0.
+//   - bool: True if the ratio is valid, otherwise false.
+func (i *Int) Ratio(denom Int) (float64, bool) {
+	if !i.present || !denom.present || denom.value == 0 {
+		return 0, false
+	}
+	return float64(i.value) / float64(denom.value), true
+}
+
+// ParseInts decodes a JSON array into a slice of Int values.
+// Each element is decoded with Int.UnmarshalJSON, so quoted numbers are
+// accepted and null elements become absent entries. A null array returns nil.
+//
+// Parameters:
+//   - data: The JSON array to decode.
+//
+// Returns:
+//   - []Int: The decoded values.
+//   - error: An error naming the offending element if decoding fails, otherwise nil.
+func ParseInts(data []byte) ([]Int, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	ints := make([]Int, len(raw))
+	for idx, element := range raw {
+		if err := ints[idx].UnmarshalJSON(element); err != nil {
+			return nil, fmt.Errorf("element %d: %w", idx, err)
+		}
+	}
+
+	return ints, nil
+}
+
+// Format returns the decimal form of the integer with digits grouped in
+// thousands by sep, e.g. "1,234,567" or "-1 000". It returns an empty
+// string if the integer is not present.
+//
+// Parameters:
+//   - sep: The separator inserted between groups of three digits.
+//
+// Returns:
+//   - string: The grouped number if present, otherwise an empty string.
+func (i *Int) Format(sep string) string {
+	if !i.present {
+		return ""
+	}
+
+	digits := strconv.Itoa(i.value)
+	sign := ""
+	if digits[0] == '-' {
+		sign, digits = "-", digits[1:]
+	}
+
+	var b strings.Builder
+	b.Grow(len(sign) + len(digits) + len(digits)/3*len(sep))
+	b.WriteString(sign)
+	head := len(digits) % 3
+	if head == 0 {
+		head = 3
+	}
+	b.WriteString(digits[:head])
+	for pos := head; pos < len(digits); pos += 3 {
+		b.WriteString(sep)
+		b.WriteString(digits[pos : pos+3])
+	}
+
+	return b.String()
+}
+
+// FormatBase returns the integer in the given base using lower-case letters
+// for digit values of 10 and above, e.g. "zz" for 1295 in base 36. It returns
+// an empty string if the integer is not present.
+// It panics if base is not in the range [2, 36], as strconv.FormatInt does.
+//
+// Parameters:
+//   - base: The base in the range [2, 36].
+//
+// Returns:
+//   - string: The formatted number if present, otherwise an empty string.
+func (i *Int) FormatBase(base int) string {
+	if base < 2 || base > 36 {
+		panic(fmt.Sprintf("params: Int.FormatBase called with invalid base %d", base))
+	}
+	if !i.present {
+		return ""
+	}
+	return strconv.FormatInt(int64(i.value), base)
+}
+
+// Ptr returns a pointer to a copy of the integer value, or nil if it is not present.
+// It converts the Int type to the pointer-based optional form used by many generated clients.
+//
+// Returns:
+//   - *int: A pointer to the value if present, otherwise nil.
+func (i *Int) Ptr() *int {
+	if !i.present {
+		return nil
+	}
+	v := i.value
+	return &v
+}
+
+// IntFromPtr creates a Int from a pointer-based optional value.
+// A nil pointer yields an absent Int, otherwise the pointed-to value is copied and marked present.
+// IntFromPtr(v.Ptr()) keeps the value and presence of v, but not its options
+// such as SetJSSafe or SetKeepRaw, so a raw token is not passed through afterwards.
+//
+// Parameters:
+//   - p: The pointer to convert.
+//
+// Returns:
+//   - Int: The converted Int.
+func IntFromPtr(p *int) Int {
+	var v Int
+	if p != nil {
+		v.Set(*p)
+	}
+	return v
+}
+
+// Mod returns a copy of the Int with the value replaced by value % n.
+// The result has the sign of the value as with Go's % operator, so callers
+// bucketing negative values may want to add n to negative results.
+// The present flag is preserved, so an absent Int stays absent.
+// It panics if n is zero, even when the Int is absent.
+//
+// Parameters:
+//   - n: The divisor.
+//
+// Returns:
+//   - Int: A new Int with the remainder.
+func (i *Int) Mod(n int) Int {
+	if n == 0 {
+		panic("params: Int.Mod called with zero divisor")
+	}
+	t := *i
+	if t.present {
+		t.value %= n
+		t.raw = ""
+	}
+	return t
+}
+
+// SatSub returns a copy of the Int with n subtracted from the value using saturating
+// arithmetic: a result below math.MinInt is clamped to math.MinInt and a result
+// above math.MaxInt, as with a negative n, is clamped to math.MaxInt, instead of
+// wrapping around like the - operator. Subtracting math.MinInt thus saturates for
+// any non-negative value. The present flag is preserved, so an absent Int stays absent.
+//
+// Parameters:
+//   - n: The number to subtract.
+//
+// Returns:
+//   - Int: A new Int with the difference.
+func (i *Int) SatSub(n int) Int {
+	t := *i
+	if t.present {
+		switch {
+		case n > 0 && t.value < math.MinInt+n:
+			t.value = math.MinInt
+		case n < 0 && t.value > math.MaxInt+n:
+			t.value = math.MaxInt
+		default:
+			t.value -= n
+		}
+		t.raw = ""
+	}
+	return t
+}
+
+// SumInts returns the sum of the present values in vals.
+// Absent entries are skipped. The result is absent if vals is empty or all
+// entries are absent, otherwise it is present. The sum uses plain int
+// arithmetic, so it wraps around on overflow like the + operator.
+//
+// Parameters:
+//   - vals: The values to sum.
+//
+// Returns:
+//   - Int: The sum of the present values.
+func SumInts(vals []Int) Int {
+	var sum Int
+	for _, v := range vals {
+		if v.present {
+			sum.value += v.value
+			sum.present = true
+		}
+	}
+	return sum
+}
@@ -0,0 +1,187 @@
+package params
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+// Int32 is an integer restricted to the int32 range, for systems whose numeric fields are strictly 32-bit.
+type Int32 struct {
+	value   int32 // Value holds the actual integer value
+	present bool  // Present indicates if the integer is present or not
+}
+
+// UnmarshalJSON implements custom unmarshalling for the Int32 type.
+// It accepts the same input as Int.UnmarshalJSON, including quoted numbers,
+// and returns an *OverflowError with a BitSize of 32 if the number does not fit into int32.
+// If the integer is null, it sets Present to false.
+//
+// Parameters:
+//   - data: The JSON data to unmarshal into the Int32 type.
+//
+// Returns:
+//   - error: An error if the unmarshalling fails or the number is out of range, otherwise nil.
+func (i *Int32) UnmarshalJSON(data []byte) error {
+	var v Int
+	err := v.UnmarshalJSON(data)
+	return i.narrow(v, err)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It allows the Int32 type to be unmarshalled from text representations.
+// This method simply calls UnmarshalJSON with the provided text data.
+//
+// Parameters:
+//   - text: The text data to unmarshal into the Int32 type.
+//
+// Returns:
+//   - error: An error if the unmarshalling fails, otherwise nil.
+func (i *Int32) UnmarshalText(text []byte) error {
+	return i.UnmarshalJSON(text)
+}
+
+// UnmarshalParam is a helper method to unmarshal a string parameter directly.
+// It accepts the same input as Int.UnmarshalParam, including base prefixes such as "0x1F".
+//
+// Parameters:
+//   - param: The string parameter to unmarshal into the Int32 type.
+//
+// Returns:
+//   - error: An error if the unmarshalling fails or the number is out of range, otherwise nil.
+func (i *Int32) UnmarshalParam(param string) error {
+	var v Int
+	err := v.UnmarshalParam(param)
+	return i.narrow(v, err)
+}
+
+// narrow stores the result of decoding v, rejecting values outside of the int32 range.
+func (i *Int32) narrow(v Int, err error) error {
+	i.value = 0
+	i.present = false
+
+	var overflow *OverflowError
+	if errors.As(err, &overflow) {
+		return &OverflowError{Value: overflow.Value, BitSize: 32}
+	}
+	if err != nil || !v.present {
+		return err
+	}
+	if v.value < math.MinInt32 || v.value > math.MaxInt32 {
+		return &OverflowError{Value: strconv.Itoa(v.value), BitSize: 32}
+	}
+
+	i.value = int32(v.value)
+	i.present = true
+
+	return nil
+}
+
+// Set sets the value of the Int32 type and marks it as present.
+//
+// Parameters:
+//   - value: The integer value to set for the Int32 type.
+func (i *Int32) Set(value int32) {
+	i.value = value
+	i.present = true
+}
+
+// Value retrieves the value of the Int32 type.
+// If the integer is not present, it returns zero.
+//
+// Returns:
+//   - int32: The value of the Int32 type if present, otherwise zero.
+func (i *Int32) Value() int32 {
+	if !i.present {
+		return 0
+	}
+	return i.value
+}
+
+// MustValue is like Value but panics if the integer is not present.
+// Like Int.MustValue, it is meant for tests, not for request-handling code.
+//
+// Returns:
+//   - int32: The value of the Int32 type.
+func (i *Int32) MustValue() int32 {
+	if !i.present {
+		panic("params: MustValue called on an absent Int32")
+	}
+	return i.value
+}
+
+// Present checks if the Int32 type is present in the JSON payload.
+// It returns true if the integer was provided in the JSON payload, otherwise false.
+//
+// Returns:
+//   - bool: True if the integer is present, otherwise false.
+func (i *Int32) Present() bool {
+	return i.present
+}
+
+// MarshalJSON implements custom marshalling for the Int32 type.
+// It emits the integer as a bare JSON number. If the integer is not present,
+// it returns null, or 0 when the package-wide policy was changed with SetNullWhenAbsent(false).
+//
+// Returns:
+//   - []byte: The JSON representation of the Int32 type.
+//   - error: An error if the marshalling fails, otherwise nil.
+func (i Int32) MarshalJSON() ([]byte, error) {
+	if !i.present && nullWhenAbsent() {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(nil, int64(i.Value()), 10), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the decimal form of the integer, or empty bytes if it is not present.
+//
+// Returns:
+//   - []byte: The text representation of the Int32 type.
+//   - error: An error if the marshalling fails, otherwise nil.
+func (i Int32) MarshalText() ([]byte, error) {
+	if !i.present {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, int64(i.value), 10), nil
+}
+
+// String implements the fmt.Stringer interface.
+// It returns "null" if the integer is not present, otherwise its decimal form.
+//
+// Returns:
+//   - string: The string representation of the Int32 type.
+func (i Int32) String() string {
+	if !i.present {
+		return "null"
+	}
+	return strconv.FormatInt(int64(i.value), 10)
+}
+
+// Ptr returns a pointer to a copy of the integer value, or nil if it is not present.
+//
+// Returns:
+//   - *int32: A pointer to the value if present, otherwise nil.
+func (i *Int32) Ptr() *int32 {
+	if !i.present {
+		return nil
+	}
+	v := i.value
+	return &v
+}
+
+// Int32FromPtr creates an Int32 from a pointer-based optional value.
+// A nil pointer yields an absent Int32, otherwise the pointed-to value is copied and marked present.
+//
+// Parameters:
+//   - p: The pointer to convert.
+//
+// Returns:
+//   - Int32: The converted Int32.
+func Int32FromPtr(p *int32) Int32 {
+	var v Int32
+	if p != nil {
+		v.Set(*p)
+	}
+	return v
+}
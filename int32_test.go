@@ -0,0 +1,120 @@
+package params
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt32(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		value    int32
+		present  bool
+		overflow string
+		wantErr  bool
+	}{
+		{name: "number", data: `42`, value: 42, present: true},
+		{name: "quoted", data: `"-42"`, value: -42, present: true},
+		{name: "null", data: `null`},
+		{name: "max", data: `2147483647`, value: math.MaxInt32, present: true},
+		{name: "min", data: `-2147483648`, value: math.MinInt32, present: true},
+		{name: "above max", data: `2147483648`, overflow: "2147483648", wantErr: true},
+		{name: "below min", data: `"-2147483649"`, overflow: "-2147483649", wantErr: true},
+		{name: "beyond int64", data: `9223372036854775808`, overflow: "9223372036854775808", wantErr: true},
+		{name: "fraction", data: `1.5`, wantErr: true},
+		{name: "text", data: `"abc"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int32
+			i.Set(7)
+			err := i.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err, "UnmarshalJSON should return an error")
+				require.False(t, i.Present(), "Int32 should not be present")
+				if tt.overflow != "" {
+					var overflow *OverflowError
+					require.True(t, errors.As(err, &overflow), "error should be an OverflowError")
+					require.Equal(t, tt.overflow, overflow.Value, "OverflowError value mismatch")
+					require.Equal(t, 32, overflow.BitSize, "OverflowError bit size mismatch")
+				}
+				return
+			}
+			require.NoError(t, err, "UnmarshalJSON should not return an error")
+			require.Equal(t, tt.value, i.Value(), "Value mismatch")
+			require.Equal(t, tt.present, i.Present(), "Present mismatch")
+		})
+	}
+}
+
+func TestInt32_UnmarshalParam(t *testing.T) {
+	var i Int32
+	require.NoError(t, i.UnmarshalParam("0x7fffffff"), "UnmarshalParam should not return an error")
+	require.Equal(t, int32(math.MaxInt32), i.Value(), "Value mismatch")
+
+	require.Error(t, i.UnmarshalParam("0x80000000"), "UnmarshalParam should reject values above int32")
+	require.False(t, i.Present(), "Int32 should not be present")
+
+	require.NoError(t, i.UnmarshalParam("0123"), "UnmarshalParam should not return an error")
+	require.Equal(t, int32(123), i.Value(), "zero-padded parameters should be decimal")
+}
+
+func TestInt32_Marshal(t *testing.T) {
+	type payload struct {
+		Field Int32 `json:"field"`
+	}
+
+	var p payload
+	got, err := json.Marshal(p)
+	require.NoError(t, err, "Marshal should not return an error")
+	require.Equal(t, `{"field":null}`, string(got), "absent Int32 should marshal as null")
+
+	SetNullWhenAbsent(false)
+	t.Cleanup(func() { SetNullWhenAbsent(true) })
+	got, err = json.Marshal(p)
+	require.NoError(t, err, "Marshal should not return an error")
+	require.Equal(t, `{"field":0}`, string(got), "absent Int32 should marshal as 0 under the zero policy")
+
+	p.Field.Set(math.MinInt32)
+	got, err = json.Marshal(p)
+	require.NoError(t, err, "Marshal should not return an error")
+	require.Equal(t, `{"field":-2147483648}`, string(got), "MarshalJSON mismatch")
+
+	text, err := p.Field.MarshalText()
+	require.NoError(t, err, "MarshalText should not return an error")
+	require.Equal(t, "-2147483648", string(text), "MarshalText mismatch")
+	require.Equal(t, "-2147483648", p.Field.String(), "String mismatch")
+
+	var absent Int32
+	require.Equal(t, "null", absent.String(), "absent String mismatch")
+	text, err = absent.MarshalText()
+	require.NoError(t, err, "MarshalText should not return an error")
+	require.Empty(t, text, "absent MarshalText should be empty")
+}
+
+func TestInt32_Ptr(t *testing.T) {
+	var absent Int32
+	require.Nil(t, absent.Ptr(), "absent Int32 should return nil")
+	fromNil := Int32FromPtr(nil)
+	require.False(t, fromNil.Present(), "nil pointer should yield an absent Int32")
+
+	var v Int32
+	v.Set(42)
+	back := Int32FromPtr(v.Ptr())
+	require.True(t, back.Present(), "Int32FromPtr should mark the value present")
+	require.Equal(t, int32(42), back.Value(), "round trip mismatch")
+}
+
+func TestInt32_MustValue(t *testing.T) {
+	var i Int32
+	require.Panics(t, func() { i.MustValue() }, "MustValue should panic when absent")
+
+	i.Set(-7)
+	require.Equal(t, int32(-7), i.MustValue(), "MustValue mismatch")
+}
@@ -3,6 +3,7 @@ package params
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -53,14 +54,14 @@ func TestInt(t *testing.T) {
 		{
 			name:    "Empty JSON",
 			input:   `{}`,
-			output:  `{"field":0,"value":0}`,
+			output:  `{"field":null,"value":null}`,
 			want:    Test{},
 			wantErr: false,
 		},
 		{
 			name:    "Null JSON",
 			input:   `{"field":null,"value":null}`,
-			output:  `{"field":0,"value":0}`,
+			output:  `{"field":null,"value":null}`,
 			want:    Test{Field: want{Present: false}, Value: want{Present: false}},
 			wantErr: false,
 		},
@@ -85,7 +86,7 @@ func TestInt(t *testing.T) {
 		{
 			name:   "Missing field",
 			input:  `{"value":456}`,
-			output: `{"field":0,"value":456}`,
+			output: `{"field":null,"value":456}`,
 			want: Test{
 				Field: want{Present: false},
 				Value: want{Value: 456, Present: true},
@@ -95,7 +96,7 @@ func TestInt(t *testing.T) {
 		{
 			name:   "Missing value",
 			input:  `{"field":123}`,
-			output: `{"field":123,"value":0}`,
+			output: `{"field":123,"value":null}`,
 			want: Test{
 				Field: want{Value: 123, Present: true},
 				Value: want{Present: false},
@@ -137,3 +138,937 @@ func TestInt(t *testing.T) {
 		})
 	}
 }
+
+func TestInt_UnmarshalParam(t *testing.T) {
+	tests := []struct {
+		name    string
+		param   string
+		value   int
+		present bool
+		wantErr bool
+	}{
+		{name: "decimal", param: "123", value: 123, present: true},
+		{name: "negative", param: "-42", value: -42, present: true},
+		{name: "quoted", param: `"7"`, value: 7, present: true},
+		{name: "empty", param: "", present: false},
+		{name: "null", param: "null", present: false},
+		{name: "invalid", param: "abc", wantErr: true},
+		{name: "hexadecimal", param: "0x1F", value: 31, present: true},
+		{name: "binary", param: "0b101", value: 5, present: true},
+		{name: "octal", param: "0o17", value: 15, present: true},
+		{name: "upper-case prefix", param: "0X1F", value: 31, present: true},
+		{name: "zero-padded decimal", param: "0123", value: 123, present: true},
+		{name: "zero-padded eight", param: "010", value: 10, present: true},
+		{name: "zero-padded nine", param: "08", value: 8, present: true},
+		{name: "zero", param: "0", value: 0, present: true},
+		{name: "negative hexadecimal", param: "-0xff", value: -255, present: true},
+		{name: "underscores with prefix", param: "0x_1F", value: 31, present: true},
+		{name: "decimal underscores", param: "1_000", wantErr: true},
+		{name: "hexadecimal overflow", param: "0x10000000000000000", wantErr: true},
+		{name: "invalid hexadecimal", param: "0xZZ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			err := i.UnmarshalParam(tt.param)
+			if tt.wantErr {
+				require.Error(t, err, "UnmarshalParam should return an error")
+				return
+			}
+			require.NoError(t, err, "UnmarshalParam should not return an error")
+			require.Equal(t, tt.value, i.Value(), "Value mismatch")
+			require.Equal(t, tt.present, i.Present(), "Present mismatch")
+		})
+	}
+}
+
+func BenchmarkInt_UnmarshalParam(b *testing.B) {
+	b.Run("param", func(b *testing.B) {
+		b.ReportAllocs()
+		var i Int
+		for b.Loop() {
+			_ = i.UnmarshalParam("123456")
+		}
+	})
+	b.Run("json", func(b *testing.B) {
+		b.ReportAllocs()
+		var i Int
+		for b.Loop() {
+			_ = i.UnmarshalJSON([]byte("123456"))
+		}
+	})
+}
+
+func TestInt_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		value   int
+		present bool
+		wantErr bool
+	}{
+		{name: "nil", src: nil, present: false},
+		{name: "int64", src: int64(42), value: 42, present: true},
+		{name: "zero int64", src: int64(0), value: 0, present: true},
+		{name: "bool true", src: true, value: 1, present: true},
+		{name: "bool false", src: false, value: 0, present: true},
+		{name: "string", src: "42", value: 42, present: true},
+		{name: "negative bytes", src: []byte("-17"), value: -17, present: true},
+		{name: "zero string", src: "0", value: 0, present: true},
+		{name: "non-numeric string", src: "abc", wantErr: true},
+		{name: "decimal string", src: "1.5", wantErr: true},
+		{name: "empty bytes", src: []byte{}, wantErr: true},
+		{name: "overflowing string", src: "99999999999999999999", wantErr: true},
+		{name: "unsupported type", src: 1.5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			err := i.Scan(tt.src)
+			if tt.wantErr {
+				require.Error(t, err, "Scan should return an error")
+				require.False(t, i.Present(), "Int should not be present")
+				return
+			}
+			require.NoError(t, err, "Scan should not return an error")
+			require.Equal(t, tt.value, i.Value(), "Value mismatch")
+			require.Equal(t, tt.present, i.Present(), "Present mismatch")
+		})
+	}
+}
+
+func TestInt_SetOverflowClamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		clamp   bool
+		value   int
+		present bool
+		wantErr bool
+	}{
+		{name: "overflow rejected by default", data: `9223372036854775808`, wantErr: true},
+		{name: "underflow rejected by default", data: `-9223372036854775809`, wantErr: true},
+		{name: "overflow clamped", data: `9223372036854775808`, clamp: true, value: math.MaxInt, present: true},
+		{name: "underflow clamped", data: `-9223372036854775809`, clamp: true, value: math.MinInt, present: true},
+		{name: "quoted overflow clamped", data: `"100000000000000000000000"`, clamp: true, value: math.MaxInt, present: true},
+		{name: "in range with clamp", data: `42`, clamp: true, value: 42, present: true},
+		{name: "invalid with clamp", data: `"abc"`, clamp: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			i.SetOverflowClamp(tt.clamp)
+			err := i.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err, "UnmarshalJSON should return an error")
+				require.False(t, i.Present(), "Int should not be present")
+				return
+			}
+			require.NoError(t, err, "UnmarshalJSON should not return an error")
+			require.Equal(t, tt.value, i.Value(), "Value mismatch")
+			require.Equal(t, tt.present, i.Present(), "Present mismatch")
+		})
+	}
+}
+
+func TestInt_Hash(t *testing.T) {
+	var absent, zero, one, other Int
+	zero.Set(0)
+	one.Set(1)
+	other.Set(1)
+
+	require.Equal(t, uint64(0xaf63bd4c8601b7df), absent.Hash(), "absent hash should be FNV-1a of 0x00")
+	require.Equal(t, uint64(0x529a2cdc8ff533ac), zero.Hash(), "hash of zero should be stable")
+	require.NotEqual(t, absent.Hash(), zero.Hash(), "absent and zero should hash differently")
+	require.NotEqual(t, zero.Hash(), one.Hash(), "different values should hash differently")
+	require.Equal(t, one.Hash(), other.Hash(), "equal values should hash equally")
+}
+
+func TestInt_String(t *testing.T) {
+	var i Int
+	require.Equal(t, "null", i.String(), "absent Int should print as null")
+	i.Set(-42)
+	require.Equal(t, "-42", i.String(), "present Int should print its value")
+	require.Equal(t, "-42", fmt.Sprintf("%v", i), "%%v should use String()")
+}
+
+func TestSetNullWhenAbsent(t *testing.T) {
+	t.Cleanup(func() { SetNullWhenAbsent(true) })
+
+	type result struct {
+		Field Int `json:"field"`
+	}
+
+	js, err := json.Marshal(result{})
+	require.NoError(t, err, "Marshal should not return an error")
+	require.JSONEq(t, `{"field":null}`, string(js), "absent Int should marshal as null by default")
+
+	SetNullWhenAbsent(false)
+	js, err = json.Marshal(result{})
+	require.NoError(t, err, "Marshal should not return an error")
+	require.JSONEq(t, `{"field":0}`, string(js), "absent Int should marshal as 0 when disabled")
+}
+
+func TestInt_UnmarshalJSONZeroFraction(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		value   int
+		wantErr bool
+	}{
+		{name: "zero fraction", data: `123.0`, value: 123},
+		{name: "quoted zero fraction", data: `"123.00"`, value: 123},
+		{name: "negative zero fraction", data: `-7.0`, value: -7},
+		{name: "exponent", data: `1e3`, value: 1000},
+		{name: "fractional", data: `123.4`, wantErr: true},
+		{name: "small fraction", data: `0.5`, wantErr: true},
+		{name: "negative exponent", data: `15e-1`, wantErr: true},
+		{name: "float overflow", data: `1e19`, wantErr: true},
+		{name: "huge exponent", data: `1e400`, wantErr: true},
+		{name: "above float precision", data: `9007199254740993.0`, value: 9007199254740993},
+		{name: "maximum int64 with fraction", data: `9223372036854775807.0`, value: math.MaxInt64},
+		{name: "minimum int64 with fraction", data: `-9223372036854775808.0`, value: math.MinInt64},
+		{name: "above maximum int64 with fraction", data: `9223372036854775808.0`, wantErr: true},
+		{name: "exponent on decimal", data: `1.25e2`, value: 125},
+		{name: "exponent below fraction", data: `1.25e1`, wantErr: true},
+		{name: "trailing zeros before negative exponent", data: `1500e-2`, value: 15},
+		{name: "zero with exponent", data: `0e5`, value: 0},
+		{name: "tiny negative exponent", data: `1e-400`, wantErr: true},
+		{name: "huge exponent digits", data: `1e99999999999999999999`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			err := i.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err, "UnmarshalJSON should return an error")
+				require.False(t, i.Present(), "Int should not be present")
+				return
+			}
+			require.NoError(t, err, "UnmarshalJSON should not return an error")
+			require.Equal(t, tt.value, i.Value(), "Value mismatch")
+			require.True(t, i.Present(), "Int should be present")
+		})
+	}
+
+	var i Int
+	i.SetOverflowClamp(true)
+	require.NoError(t, i.UnmarshalJSON([]byte(`-1e30`)), "clamped float overflow should not return an error")
+	require.Equal(t, math.MinInt, i.Value(), "float overflow should clamp")
+}
+
+func TestInt_Sign(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    int
+		present  bool
+		negative bool
+		positive bool
+	}{
+		{name: "absent"},
+		{name: "negative", value: -5, present: true, negative: true},
+		{name: "zero", value: 0, present: true},
+		{name: "positive", value: 5, present: true, positive: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			if tt.present {
+				i.Set(tt.value)
+			}
+			require.Equal(t, tt.negative, i.IsNegative(), "IsNegative mismatch")
+			require.Equal(t, tt.positive, i.IsPositive(), "IsPositive mismatch")
+		})
+	}
+}
+
+func TestInt_Clamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   int
+		present bool
+		want    int
+	}{
+		{name: "absent", present: false, want: 0},
+		{name: "below range", value: -5, present: true, want: 0},
+		{name: "in range", value: 5, present: true, want: 5},
+		{name: "above range", value: 50, present: true, want: 10},
+		{name: "on bound", value: 10, present: true, want: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			if tt.present {
+				i.Set(tt.value)
+			}
+			got := i.Clamp(0, 10)
+			require.Equal(t, tt.present, got.Present(), "Present mismatch")
+			require.Equal(t, tt.want, got.Value(), "Value mismatch")
+		})
+	}
+
+	var i Int
+	require.Panics(t, func() { i.Clamp(10, 0) }, "Clamp should panic when lo > hi")
+}
+
+func TestParseInts(t *testing.T) {
+	type want struct {
+		Value   int
+		Present bool
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    []want
+		wantErr bool
+	}{
+		{name: "quoted numbers", input: `["1","2","3"]`, want: []want{{1, true}, {2, true}, {3, true}}},
+		{name: "mixed with null", input: `[1,null,"3"]`, want: []want{{1, true}, {0, false}, {3, true}}},
+		{name: "empty array", input: `[]`, want: []want{}},
+		{name: "null array", input: `null`, want: nil},
+		{name: "invalid element", input: `[1,"x"]`, wantErr: true},
+		{name: "not an array", input: `{"a":1}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseInts([]byte(tt.input))
+			if tt.wantErr {
+				require.Error(t, err, "ParseInts should return an error")
+				return
+			}
+			require.NoError(t, err, "ParseInts should not return an error")
+			if tt.want == nil {
+				require.Nil(t, got, "ParseInts should return nil")
+				return
+			}
+			require.Len(t, got, len(tt.want), "length mismatch")
+			for idx, w := range tt.want {
+				require.Equal(t, w.Value, got[idx].Value(), "Value mismatch at %d", idx)
+				require.Equal(t, w.Present, got[idx].Present(), "Present mismatch at %d", idx)
+			}
+		})
+	}
+}
+
+func TestInt_UnmarshalJSONDecimalOnly(t *testing.T) {
+	for _, data := range []string{`"0x1F"`, `"0b101"`, `0x1F`} {
+		var i Int
+		require.Error(t, i.UnmarshalJSON([]byte(data)), "UnmarshalJSON should reject %s", data)
+	}
+}
+
+func TestInt_Format(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   int
+		present bool
+		sep     string
+		want    string
+	}{
+		{name: "absent", sep: ",", want: ""},
+		{name: "zero", value: 0, present: true, sep: ",", want: "0"},
+		{name: "small", value: 999, present: true, sep: ",", want: "999"},
+		{name: "thousand", value: 1000, present: true, sep: ",", want: "1,000"},
+		{name: "millions", value: 1234567, present: true, sep: ",", want: "1,234,567"},
+		{name: "negative", value: -1234567, present: true, sep: ",", want: "-1,234,567"},
+		{name: "negative small", value: -12, present: true, sep: ",", want: "-12"},
+		{name: "custom separator", value: 12345678, present: true, sep: " ", want: "12 345 678"},
+		{name: "minimum int", value: math.MinInt64, present: true, sep: ",", want: "-9,223,372,036,854,775,808"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			if tt.present {
+				i.Set(tt.value)
+			}
+			require.Equal(t, tt.want, i.Format(tt.sep), "Format mismatch")
+		})
+	}
+}
+
+func TestInt_Ptr(t *testing.T) {
+	var absent Int
+	require.Nil(t, absent.Ptr(), "absent Int should return nil")
+	fromNil := IntFromPtr(nil)
+	require.False(t, fromNil.Present(), "nil pointer should yield an absent Int")
+
+	var v Int
+	v.Set(42)
+	p := v.Ptr()
+	require.NotNil(t, p, "present Int should return a pointer")
+	require.Equal(t, 42, *p, "Ptr value mismatch")
+
+	back := IntFromPtr(p)
+	require.True(t, back.Present(), "IntFromPtr should mark the value present")
+	require.Equal(t, v.Value(), back.Value(), "round trip mismatch")
+	roundTrip := IntFromPtr(absent.Ptr())
+	require.False(t, roundTrip.Present(), "absent round trip should stay absent")
+}
+
+func TestInt_Mod(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   int
+		present bool
+		n       int
+		want    int
+	}{
+		{name: "absent", n: 10, want: 0},
+		{name: "positive", value: 17, present: true, n: 5, want: 2},
+		{name: "exact", value: 15, present: true, n: 5, want: 0},
+		{name: "negative value", value: -17, present: true, n: 5, want: -2},
+		{name: "negative divisor", value: 17, present: true, n: -5, want: 2},
+		{name: "minimum int by minus one", value: math.MinInt, present: true, n: -1, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			if tt.present {
+				i.Set(tt.value)
+			}
+			got := i.Mod(tt.n)
+			require.Equal(t, tt.present, got.Present(), "Present mismatch")
+			require.Equal(t, tt.want, got.Value(), "Mod mismatch")
+		})
+	}
+
+	var i Int
+	require.Panics(t, func() { i.Mod(0) }, "Mod should panic on zero divisor")
+}
+
+func TestInt_SatSub(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   int
+		present bool
+		n       int
+		want    int
+	}{
+		{name: "absent", n: 10, want: 0},
+		{name: "plain", value: 10, present: true, n: 3, want: 7},
+		{name: "below zero", value: 3, present: true, n: 10, want: -7},
+		{name: "negative n", value: 3, present: true, n: -10, want: 13},
+		{name: "underflow", value: math.MinInt + 5, present: true, n: 10, want: math.MinInt},
+		{name: "exact minimum", value: math.MinInt + 10, present: true, n: 10, want: math.MinInt},
+		{name: "overflow", value: math.MaxInt - 5, present: true, n: -10, want: math.MaxInt},
+		{name: "minimum int subtracted", value: 0, present: true, n: math.MinInt, want: math.MaxInt},
+		{name: "minimum int from negative", value: -1, present: true, n: math.MinInt, want: math.MaxInt},
+		{name: "maximum int from minimum", value: math.MinInt, present: true, n: math.MaxInt, want: math.MinInt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			if tt.present {
+				i.Set(tt.value)
+			}
+			got := i.SatSub(tt.n)
+			require.Equal(t, tt.present, got.Present(), "Present mismatch")
+			require.Equal(t, tt.want, got.Value(), "SatSub mismatch")
+		})
+	}
+}
+
+func TestInt_SetStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		strict  bool
+		value   int
+		errText string
+	}{
+		{name: "padded lenient", data: `" 123 "`, value: 123},
+		{name: "tab padded lenient", data: `"\t42"`, value: 42},
+		{name: "padded strict", data: `" 123 "`, strict: true, errText: "contains whitespace"},
+		{name: "trailing space strict", data: `"123 "`, strict: true, errText: "contains whitespace"},
+		{name: "quoted strict", data: `"123"`, strict: true, value: 123},
+		{name: "bare strict", data: `123`, strict: true, value: 123},
+		{name: "inner whitespace", data: `"1 23"`, errText: "invalid"},
+		{name: "inner whitespace strict", data: `"1 23"`, strict: true, errText: "quoted number contains whitespace"},
+		{name: "inner tab strict", data: `"1\t23"`, strict: true, errText: "quoted number contains whitespace"},
+		{name: "blank string", data: `"  "`, errText: "invalid number format"},
+		{name: "double quoted", data: `"\"5\""`, errText: "invalid number format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			i.SetStrict(tt.strict)
+			err := i.UnmarshalJSON([]byte(tt.data))
+			if tt.errText != "" {
+				require.ErrorContains(t, err, tt.errText, "UnmarshalJSON error mismatch")
+				require.False(t, i.Present(), "Int should not be present")
+				return
+			}
+			require.NoError(t, err, "UnmarshalJSON should not return an error")
+			require.Equal(t, tt.value, i.Value(), "Value mismatch")
+			require.True(t, i.Present(), "Int should be present")
+		})
+	}
+}
+
+func TestSumInts(t *testing.T) {
+	present := func(v int) Int {
+		var i Int
+		i.Set(v)
+		return i
+	}
+
+	tests := []struct {
+		name    string
+		vals    []Int
+		value   int
+		present bool
+	}{
+		{name: "nil slice", vals: nil},
+		{name: "all absent", vals: []Int{{}, {}}},
+		{name: "mixed", vals: []Int{present(1), {}, present(2), present(-5)}, value: -2, present: true},
+		{name: "zero sum", vals: []Int{present(0)}, value: 0, present: true},
+		{name: "overflow wraps", vals: []Int{present(math.MaxInt), present(1)}, value: math.MinInt, present: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum := SumInts(tt.vals)
+			require.Equal(t, tt.value, sum.Value(), "Value mismatch")
+			require.Equal(t, tt.present, sum.Present(), "Present mismatch")
+		})
+	}
+}
+
+func TestInt_FormatBase(t *testing.T) {
+	var absent Int
+	require.Equal(t, "", absent.FormatBase(36), "absent Int should format as empty")
+
+	var i Int
+	i.Set(1295)
+	require.Equal(t, "zz", i.FormatBase(36), "base 36 mismatch")
+	require.Equal(t, "10100001111", i.FormatBase(2), "base 2 mismatch")
+	i.Set(-255)
+	require.Equal(t, "-ff", i.FormatBase(16), "negative base 16 mismatch")
+
+	require.Panics(t, func() { i.FormatBase(1) }, "FormatBase should panic on base 1")
+	require.Panics(t, func() { i.FormatBase(37) }, "FormatBase should panic on base 37")
+}
+
+func TestInt_SetBase(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		base    int
+		value   int
+		wantErr bool
+	}{
+		{name: "quoted base 36", data: `"zz"`, base: 36, value: 1295},
+		{name: "upper case base 36", data: `"ZZ"`, base: 36, value: 1295},
+		{name: "bare base 2", data: `101`, base: 2, value: 5},
+		{name: "negative base 16", data: `"-ff"`, base: 16, value: -255},
+		{name: "digit out of base", data: `"12"`, base: 2, wantErr: true},
+		{name: "fraction rejected", data: `"1.0"`, base: 10, wantErr: true},
+		{name: "overflow", data: `"zzzzzzzzzzzzzzz"`, base: 36, wantErr: true},
+		{name: "null stays absent", data: `null`, base: 36},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			i.SetBase(tt.base)
+			err := i.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err, "UnmarshalJSON should return an error")
+				require.False(t, i.Present(), "Int should not be present")
+				return
+			}
+			require.NoError(t, err, "UnmarshalJSON should not return an error")
+			require.Equal(t, tt.value, i.Value(), "Value mismatch")
+			require.Equal(t, tt.data != "null", i.Present(), "Present mismatch")
+		})
+	}
+
+	var i Int
+	i.SetBase(36)
+	require.NoError(t, i.UnmarshalParam("2s"), "UnmarshalParam should not return an error")
+	require.Equal(t, 100, i.Value(), "UnmarshalParam base 36 mismatch")
+	require.Equal(t, "2s", i.FormatBase(36), "round trip mismatch")
+
+	i.SetBase(0)
+	require.NoError(t, i.UnmarshalParam("0x10"), "default parsing should be restored")
+	require.Equal(t, 16, i.Value(), "default parsing mismatch")
+
+	require.Panics(t, func() { i.SetBase(1) }, "SetBase should panic on base 1")
+	require.Panics(t, func() { i.SetBase(37) }, "SetBase should panic on base 37")
+}
+
+func TestInt_SetAcceptBool(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		accept  bool
+		value   int
+		wantErr bool
+	}{
+		{name: "true rejected by default", data: `true`, wantErr: true},
+		{name: "false rejected by default", data: `false`, wantErr: true},
+		{name: "true accepted", data: `true`, accept: true, value: 1},
+		{name: "false accepted", data: `false`, accept: true, value: 0},
+		{name: "quoted true rejected", data: `"true"`, accept: true, wantErr: true},
+		{name: "number still accepted", data: `42`, accept: true, value: 42},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			i.SetAcceptBool(tt.accept)
+			err := i.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err, "UnmarshalJSON should return an error")
+				require.False(t, i.Present(), "Int should not be present")
+				return
+			}
+			require.NoError(t, err, "UnmarshalJSON should not return an error")
+			require.Equal(t, tt.value, i.Value(), "Value mismatch")
+			require.True(t, i.Present(), "Int should be present")
+		})
+	}
+}
+
+func TestInt_Ratio(t *testing.T) {
+	present := func(v int) Int {
+		var i Int
+		i.Set(v)
+		return i
+	}
+
+	tests := []struct {
+		name   string
+		num    Int
+		denom  Int
+		want   float64
+		wantOK bool
+	}{
+		{name: "rate", num: present(3), denom: present(4), want: 0.75, wantOK: true},
+		{name: "negative", num: present(-1), denom: present(2), want: -0.5, wantOK: true},
+		{name: "zero denominator", num: present(1), denom: present(0)},
+		{name: "absent numerator", denom: present(2)},
+		{name: "absent denominator", num: present(2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.num.Ratio(tt.denom)
+			require.Equal(t, tt.wantOK, ok, "valid flag mismatch")
+			require.Equal(t, tt.want, got, "Ratio mismatch")
+		})
+	}
+}
+
+func TestInt_MustValue(t *testing.T) {
+	var i Int
+	require.PanicsWithValue(t, "params: MustValue called on an absent Int", func() { i.MustValue() }, "MustValue should panic when absent")
+
+	i.Set(0)
+	require.Equal(t, 0, i.MustValue(), "MustValue mismatch")
+}
+
+func TestInt_SetJSSafe(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  int64
+		jsSafe bool
+		want   string
+	}{
+		{name: "large bare by default", value: 1 << 60, want: "1152921504606846976"},
+		{name: "max safe stays bare", value: 9007199254740991, jsSafe: true, want: "9007199254740991"},
+		{name: "beyond safe quoted", value: 9007199254740992, jsSafe: true, want: `"9007199254740992"`},
+		{name: "negative beyond safe quoted", value: -9007199254740992, jsSafe: true, want: `"-9007199254740992"`},
+		{name: "min safe stays bare", value: -9007199254740991, jsSafe: true, want: "-9007199254740991"},
+		{name: "small bare", value: 42, jsSafe: true, want: "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if int64(int(tt.value)) != tt.value {
+				t.Skip("value does not fit into int on this platform")
+			}
+			var i Int
+			i.SetJSSafe(tt.jsSafe)
+			i.Set(int(tt.value))
+			got, err := json.Marshal(i)
+			require.NoError(t, err, "MarshalJSON should not return an error")
+			require.Equal(t, tt.want, string(got), "MarshalJSON mismatch")
+
+			var back Int
+			require.NoError(t, json.Unmarshal(got, &back), "round trip should not return an error")
+			require.Equal(t, i.Value(), back.Value(), "round trip mismatch")
+		})
+	}
+}
+
+func TestInt_SetDefault(t *testing.T) {
+	var i Int
+	require.NoError(t, i.UnmarshalParam(""), "UnmarshalParam should not return an error")
+	require.False(t, i.Present(), "empty parameter should stay absent without a default")
+
+	i.SetDefault(20)
+	require.NoError(t, i.UnmarshalParam(""), "UnmarshalParam should not return an error")
+	require.True(t, i.Present(), "empty parameter should be present with a default")
+	require.Equal(t, 20, i.Value(), "default mismatch")
+
+	require.NoError(t, i.UnmarshalParam("5"), "UnmarshalParam should not return an error")
+	require.Equal(t, 5, i.Value(), "explicit value should win over the default")
+
+	require.NoError(t, i.UnmarshalJSON([]byte("null")), "UnmarshalJSON should not return an error")
+	require.False(t, i.Present(), "default should not apply to JSON null")
+}
+
+func TestInt_SetKeepRaw(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{name: "quoted", data: `"42"`, want: `"42"`},
+		{name: "negative zero", data: `-0`, want: `-0`},
+		{name: "exponent", data: `1.0e3`, want: `1.0e3`},
+		{name: "padded quoted", data: `" 7 "`, want: `" 7 "`},
+		{name: "plain", data: `12`, want: `12`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var canonical Int
+			require.NoError(t, canonical.UnmarshalJSON([]byte(tt.data)), "UnmarshalJSON should not return an error")
+			require.Nil(t, canonical.RawValue(), "raw form should not be recorded by default")
+
+			var i Int
+			i.SetKeepRaw(true)
+			require.NoError(t, i.UnmarshalJSON([]byte(tt.data)), "UnmarshalJSON should not return an error")
+			require.Equal(t, canonical.Value(), i.Value(), "Value should not depend on raw mode")
+			require.Equal(t, []byte(tt.data), i.RawValue(), "RawValue mismatch")
+
+			got, err := json.Marshal(i)
+			require.NoError(t, err, "MarshalJSON should not return an error")
+			require.Equal(t, tt.want, string(got), "MarshalJSON should emit the raw token")
+		})
+	}
+
+	var i Int
+	i.SetKeepRaw(true)
+	require.NoError(t, i.UnmarshalJSON([]byte(`"42"`)), "UnmarshalJSON should not return an error")
+	clamped := i.Clamp(0, 10)
+	got, err := json.Marshal(clamped)
+	require.NoError(t, err, "MarshalJSON should not return an error")
+	require.Equal(t, "10", string(got), "transformed value should marshal canonically")
+
+	i.Set(5)
+	require.Nil(t, i.RawValue(), "Set should drop the raw form")
+
+	require.NoError(t, i.UnmarshalJSON([]byte(`null`)), "UnmarshalJSON should not return an error")
+	require.Nil(t, i.RawValue(), "null should not be recorded")
+
+	require.NoError(t, i.UnmarshalParam("0x10"), "UnmarshalParam should not return an error")
+	require.Nil(t, i.RawValue(), "parameters should not be recorded")
+}
+
+func TestInt_SetLargeNumberPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		param   bool
+		policy  LargeNumberPolicy
+		value   int
+		wantErr bool
+	}{
+		{name: "error by default", data: `9223372036854775808`, wantErr: true},
+		{name: "saturate max", data: `9223372036854775808`, policy: LargeNumberSaturate, value: math.MaxInt},
+		{name: "saturate min", data: `"-99999999999999999999"`, policy: LargeNumberSaturate, value: math.MinInt},
+		{name: "truncate wraps", data: `9223372036854775808`, policy: LargeNumberTruncate, value: math.MinInt},
+		{name: "truncate 2^64 plus one", data: `"18446744073709551617"`, policy: LargeNumberTruncate, value: 1},
+		{name: "truncate negative", data: `-18446744073709551617`, policy: LargeNumberTruncate, value: -1},
+		{name: "truncate hex param", data: "0x1_0000_0000_0000_0002", param: true, policy: LargeNumberTruncate, value: 2},
+		{name: "truncate rejects exponent", data: `1e30`, policy: LargeNumberTruncate, wantErr: true},
+		{name: "in range unaffected", data: `42`, policy: LargeNumberTruncate, value: 42},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			i.SetLargeNumberPolicy(tt.policy)
+			var err error
+			if tt.param {
+				err = i.UnmarshalParam(tt.data)
+			} else {
+				err = i.UnmarshalJSON([]byte(tt.data))
+			}
+			if tt.wantErr {
+				var overflow *OverflowError
+				require.ErrorAs(t, err, &overflow, "error should be an OverflowError")
+				require.False(t, i.Present(), "Int should not be present")
+				return
+			}
+			require.NoError(t, err, "unmarshalling should not return an error")
+			require.Equal(t, tt.value, i.Value(), "Value mismatch")
+			require.True(t, i.Present(), "Int should be present")
+		})
+	}
+
+	var i Int
+	i.SetLargeNumberPolicy(LargeNumberTruncate)
+	i.SetOverflowClamp(true)
+	require.NoError(t, i.UnmarshalJSON([]byte(`9223372036854775808`)), "clamp should replace the policy")
+	require.Equal(t, math.MaxInt, i.Value(), "clamp mismatch")
+	i.SetOverflowClamp(false)
+	require.Error(t, i.UnmarshalJSON([]byte(`9223372036854775808`)), "disabling clamp should restore the error policy")
+}
+
+func TestInt_SetZeroAsAbsent(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		param   bool
+		enabled bool
+		value   int
+		present bool
+	}{
+		{name: "zero present by default", data: `0`, value: 0, present: true},
+		{name: "zero absent", data: `0`, enabled: true},
+		{name: "quoted zero absent", data: `"0"`, enabled: true},
+		{name: "float zero absent", data: `0.0`, enabled: true},
+		{name: "param zero absent", data: "0", param: true, enabled: true},
+		{name: "param zero present by default", data: "0", param: true, present: true},
+		{name: "non-zero present", data: `5`, enabled: true, value: 5, present: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			i.SetZeroAsAbsent(tt.enabled)
+			var err error
+			if tt.param {
+				err = i.UnmarshalParam(tt.data)
+			} else {
+				err = i.UnmarshalJSON([]byte(tt.data))
+			}
+			require.NoError(t, err, "unmarshalling should not return an error")
+			require.Equal(t, tt.value, i.Value(), "Value mismatch")
+			require.Equal(t, tt.present, i.Present(), "Present mismatch")
+		})
+	}
+}
+
+func TestInt_SetAllowThousandsSep(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		param   bool
+		allow   bool
+		sep     string
+		value   int
+		wantErr bool
+	}{
+		{name: "rejected by default", data: `"1,234"`, wantErr: true},
+		{name: "param rejected by default", data: "1,234", param: true, wantErr: true},
+		{name: "quoted grouped", data: `"1,234,567"`, allow: true, value: 1234567},
+		{name: "negative grouped", data: `"-1,000"`, allow: true, value: -1000},
+		{name: "param grouped", data: "12,345", param: true, allow: true, value: 12345},
+		{name: "ungrouped still accepted", data: `"1234"`, allow: true, value: 1234},
+		{name: "short group", data: `"1,23"`, allow: true, wantErr: true},
+		{name: "long first group", data: `"1234,567"`, allow: true, wantErr: true},
+		{name: "empty group", data: "1,,234", param: true, allow: true, wantErr: true},
+		{name: "custom separator", data: `"1.234.567"`, allow: true, sep: ".", value: 1234567},
+		{name: "default separator replaced", data: `"1,234"`, allow: true, sep: ".", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			i.SetAllowThousandsSep(tt.allow)
+			i.SetThousandsSep(tt.sep)
+			var err error
+			if tt.param {
+				err = i.UnmarshalParam(tt.data)
+			} else {
+				err = i.UnmarshalJSON([]byte(tt.data))
+			}
+			if tt.wantErr {
+				require.Error(t, err, "unmarshalling should return an error")
+				require.False(t, i.Present(), "Int should not be present")
+				return
+			}
+			require.NoError(t, err, "unmarshalling should not return an error")
+			require.Equal(t, tt.value, i.Value(), "Value mismatch")
+			require.True(t, i.Present(), "Int should be present")
+		})
+	}
+}
+
+func TestInt_AppendTo(t *testing.T) {
+	var present, negative, absent, null Int
+	present.Set(42)
+	negative.Set(-7)
+	null.SetAbsentText(`\N`)
+
+	var row []byte
+	for idx, v := range []Int{present, absent, negative, null} {
+		if idx > 0 {
+			row = append(row, ',')
+		}
+		row = v.AppendTo(row)
+	}
+	require.Equal(t, `42,,-7,\N`, string(row), "AppendTo row mismatch")
+
+	buf := make([]byte, 0, 32)
+	require.Zero(t, testing.AllocsPerRun(100, func() {
+		buf = present.AppendTo(buf[:0])
+	}), "AppendTo should not allocate with enough capacity")
+}
+
+func TestInt_LongDigitStrings(t *testing.T) {
+	long := "123456789012345678901234567890"
+	tests := []struct {
+		name     string
+		data     string
+		policy   LargeNumberPolicy
+		value    int
+		overflow string
+	}{
+		{name: "quoted", data: `"` + long + `"`, overflow: long},
+		{name: "bare", data: long, overflow: long},
+		{name: "negative", data: "-" + long, overflow: "-" + long},
+		{name: "saturated", data: `"` + long + `"`, policy: LargeNumberSaturate, value: math.MaxInt},
+		{name: "negative saturated", data: "-" + long, policy: LargeNumberSaturate, value: math.MinInt},
+		{name: "leading zeros stay a syntax error", data: "0" + long},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var i Int
+			i.SetLargeNumberPolicy(tt.policy)
+			err := i.UnmarshalJSON([]byte(tt.data))
+			if tt.policy != LargeNumberError {
+				require.NoError(t, err, "UnmarshalJSON should not return an error")
+				require.Equal(t, tt.value, i.Value(), "Value mismatch")
+				return
+			}
+			require.Error(t, err, "UnmarshalJSON should return an error")
+			require.False(t, i.Present(), "Int should not be present")
+			var overflow *OverflowError
+			if tt.overflow == "" {
+				require.NotErrorAs(t, err, &overflow, "error should not be an OverflowError")
+				return
+			}
+			require.ErrorAs(t, err, &overflow, "error should be an OverflowError")
+			require.Equal(t, tt.overflow, overflow.Value, "OverflowError.Value mismatch")
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package params
+
+import (
+	"sync"
+	"time"
+)
+
+// TimeParser parses a time string that matches none of the built-in layouts.
+// It returns false if str is not in the format handled by the parser.
+type TimeParser func(str string) (time.Time, bool)
+
+// timeParsers holds the parsers added with RegisterTimeParser.
+var timeParsers struct {
+	sync.RWMutex
+	list []TimeParser
+}
+
+// RegisterTimeParser adds parser to the package-wide list of fallback parsers.
+// Time.UnmarshalJSON tries the registered parsers in registration order after
+// the built-in layouts failed, so registering never changes how values in
+// the default formats are parsed. It is safe for concurrent use and is
+// typically called from an init function, e.g. RegisterTimeParser(ParseISOWeekDate).
+//
+// Parameters:
+//   - parser: The parser to register.
+func RegisterTimeParser(parser TimeParser) {
+	timeParsers.Lock()
+	defer timeParsers.Unlock()
+	timeParsers.list = append(timeParsers.list, parser)
+}
+
+// parseRegistered tries the registered parsers on str in registration order.
+func parseRegistered(str string) (time.Time, bool) {
+	timeParsers.RLock()
+	defer timeParsers.RUnlock()
+	for _, parser := range timeParsers.list {
+		if t, ok := parser(str); ok {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// ParseISOWeekDate parses an ISO 8601 week date such as "2023-W40-4",
+// i.e. the Thursday of ISO week 40 of 2023. The result is midnight UTC.
+// It returns false if str is not in the extended YYYY-Www-D form or the
+// week does not exist in the given ISO year.
+//
+// Parameters:
+//   - str: The week date to parse.
+//
+// Returns:
+//   - time.Time: The resolved date if valid.
+//   - bool: True if str is a valid week date, otherwise false.
+func ParseISOWeekDate(str string) (time.Time, bool) {
+	if len(str) != 10 || str[4] != '-' || str[5] != 'W' || str[8] != '-' {
+		return time.Time{}, false
+	}
+	year, ok1 := parseDigits(str[0:4])
+	week, ok2 := parseDigits(str[6:8])
+	day, ok3 := parseDigits(str[9:10])
+	if !ok1 || !ok2 || !ok3 || week < 1 || week > 53 || day < 1 || day > 7 {
+		return time.Time{}, false
+	}
+
+	// January 4th always falls into ISO week 1.
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+	t := monday.AddDate(0, 0, (week-1)*7+day-1)
+
+	if y, w := t.ISOWeek(); y != year || w != week {
+		return time.Time{}, false // Week 53 in a year with 52 weeks
+	}
+	return t, true
+}
+
+// ParseISOOrdinalDate parses an ISO 8601 ordinal date such as "2023-278",
+// i.e. the 278th day of 2023. The result is midnight UTC.
+// It returns false if str is not in the extended YYYY-DDD form or the
+// day does not exist in the given year.
+//
+// Parameters:
+//   - str: The ordinal date to parse.
+//
+// Returns:
+//   - time.Time: The resolved date if valid.
+//   - bool: True if str is a valid ordinal date, otherwise false.
+func ParseISOOrdinalDate(str string) (time.Time, bool) {
+	if len(str) != 8 || str[4] != '-' {
+		return time.Time{}, false
+	}
+	year, ok1 := parseDigits(str[0:4])
+	day, ok2 := parseDigits(str[5:8])
+	if !ok1 || !ok2 || day < 1 || day > 366 {
+		return time.Time{}, false
+	}
+
+	t := time.Date(year, time.January, day, 0, 0, 0, 0, time.UTC)
+	if t.Year() != year {
+		return time.Time{}, false // Day 366 in a common year
+	}
+	return t, true
+}
+
+// parseDigits converts a string of ASCII digits into an int.
+func parseDigits(str string) (int, bool) {
+	n := 0
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return 0, false
+		}
+		n = n*10 + int(str[i]-'0')
+	}
+	return n, len(str) > 0
+}
@@ -0,0 +1,81 @@
+package params
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseISOWeekDate(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want time.Time
+		ok   bool
+	}{
+		{name: "thursday of week 40", str: "2023-W40-4", want: time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC), ok: true},
+		{name: "week 1 in previous year", str: "2020-W01-1", want: time.Date(2019, 12, 30, 0, 0, 0, 0, time.UTC), ok: true},
+		{name: "week 53", str: "2020-W53-7", want: time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), ok: true},
+		{name: "week 53 missing", str: "2023-W53-1"},
+		{name: "week 0", str: "2023-W00-1"},
+		{name: "day 8", str: "2023-W40-8"},
+		{name: "basic form", str: "2023W404"},
+		{name: "ordinal date", str: "2023-278"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseISOWeekDate(tt.str)
+			require.Equal(t, tt.ok, ok, "ok mismatch")
+			require.Equal(t, tt.want, got, "ParseISOWeekDate mismatch")
+		})
+	}
+}
+
+func TestParseISOOrdinalDate(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want time.Time
+		ok   bool
+	}{
+		{name: "day 278", str: "2023-278", want: time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC), ok: true},
+		{name: "first day", str: "2023-001", want: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), ok: true},
+		{name: "leap day 366", str: "2024-366", want: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), ok: true},
+		{name: "day 366 in common year", str: "2023-366"},
+		{name: "day 0", str: "2023-000"},
+		{name: "letters", str: "2023-2x8"},
+		{name: "calendar date", str: "2023-10-05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseISOOrdinalDate(tt.str)
+			require.Equal(t, tt.ok, ok, "ok mismatch")
+			require.Equal(t, tt.want, got, "ParseISOOrdinalDate mismatch")
+		})
+	}
+}
+
+func TestRegisterTimeParser(t *testing.T) {
+	saved := timeParsers.list
+	t.Cleanup(func() { timeParsers.list = saved })
+	timeParsers.list = nil
+
+	var dst Time
+	require.Error(t, dst.UnmarshalJSON([]byte(`"2023-W40-4"`)), "week dates should be rejected by default")
+
+	RegisterTimeParser(ParseISOWeekDate)
+	RegisterTimeParser(ParseISOOrdinalDate)
+
+	want := time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, dst.UnmarshalJSON([]byte(`"2023-W40-4"`)), "registered week date should parse")
+	require.Equal(t, want, dst.Value(), "week date mismatch")
+	require.NoError(t, dst.UnmarshalJSON([]byte(`"2023-278"`)), "registered ordinal date should parse")
+	require.Equal(t, want, dst.Value(), "ordinal date mismatch")
+	require.True(t, dst.Present(), "Time should be present")
+
+	require.NoError(t, dst.UnmarshalJSON([]byte(`"2023-10-05T14:48:00Z"`)), "default layouts should still parse")
+	require.Equal(t, 14, dst.Value().Hour(), "default layout mismatch")
+}
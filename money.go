@@ -0,0 +1,157 @@
+package params
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// amountPattern matches a plain decimal amount without exponent, e.g. 12, -0.5 or 12.34.
+var amountPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?$`)
+
+// currencyCodes holds the active ISO 4217 alphabetic currency codes.
+var currencyCodes = map[string]struct{}{}
+
+func init() {
+	codes := `AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB BOV BRL BSD BTN BWP BYN BZD
+		CAD CDF CHE CHF CHW CLF CLP CNY COP COU CRC CUC CUP CVE CZK DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL
+		GHS GIP GMD GNF GTQ GYD HKD HNL HTG HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES KGS KHR KMF KPW KRW KWD KYD
+		KZT LAK LBP LKR LRD LSL LYD MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV MYR MZN NAD NGN NIO NOK NPR
+		NZD OMR PAB PEN PGK PHP PKR PLN PYG QAR RON RSD RUB RWF SAR SBD SCR SDG SEK SGD SHP SLE SLL SOS SRD SSP STN
+		SVC SYP SZL THB TJS TMT TND TOP TRY TTD TWD TZS UAH UGX USD USN UYI UYU UYW UZS VED VES VND VUV WST XAF XAG
+		XAU XBA XBB XBC XBD XCD XCG XDR XOF XPD XPF XPT XSU XTS XUA XXX YER ZAR ZMW ZWG ZWL`
+	for _, code := range strings.Fields(codes) {
+		currencyCodes[code] = struct{}{}
+	}
+}
+
+// Money is a monetary amount with an ISO 4217 currency code.
+// The amount is kept as an exact decimal string to avoid float rounding.
+type Money struct {
+	amount   string // Amount holds the exact decimal amount
+	currency string // Currency holds the ISO 4217 currency code
+	present  bool   // Present indicates if the money value is present or not
+}
+
+// moneyJSON is the wire representation of the Money type.
+type moneyJSON struct {
+	Amount   json.Number `json:"amount"`
+	Currency string      `json:"currency"`
+}
+
+// UnmarshalJSON implements custom unmarshalling for the Money type.
+// It expects an object like {"amount":"12.34","currency":"USD"}.
+// The amount may be a quoted or bare decimal number, but exponents are rejected.
+// The currency must be an active ISO 4217 code.
+// If the value is null, it sets Present to false.
+//
+// Parameters:
+//   - data: The JSON data to unmarshal into the Money type.
+//
+// Returns:
+//   - error: An error if the unmarshalling or validation fails, otherwise nil.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	m.amount = ""
+	m.currency = ""
+	m.present = false
+
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	var v moneyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	if err := validateMoney(v.Amount.String(), v.Currency); err != nil {
+		return err
+	}
+
+	m.amount = v.Amount.String()
+	m.currency = v.Currency
+	m.present = true
+
+	return nil
+}
+
+// MarshalJSON implements custom marshalling for the Money type.
+// It emits an object with the amount as a string and the currency code.
+// If the value is not present, it returns null.
+//
+// Returns:
+//   - []byte: The JSON representation of the Money type.
+//   - error: An error if the marshalling fails, otherwise nil.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if !m.present {
+		return []byte("null"), nil
+	}
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{Amount: m.amount, Currency: m.currency})
+}
+
+// Set sets the amount and currency of the Money type and marks it as present.
+// The value is left unchanged if validation fails.
+//
+// Parameters:
+//   - amount: The exact decimal amount, e.g. "12.34".
+//   - currency: The ISO 4217 currency code, e.g. "USD".
+//
+// Returns:
+//   - error: An error if the amount or currency is invalid, otherwise nil.
+func (m *Money) Set(amount, currency string) error {
+	if err := validateMoney(amount, currency); err != nil {
+		return err
+	}
+	m.amount = amount
+	m.currency = currency
+	m.present = true
+	return nil
+}
+
+// Amount retrieves the exact decimal amount of the Money type.
+// If the value is not present, it returns an empty string.
+//
+// Returns:
+//   - string: The decimal amount if present, otherwise an empty string.
+func (m *Money) Amount() string {
+	if !m.present {
+		return ""
+	}
+	return m.amount
+}
+
+// Currency retrieves the ISO 4217 currency code of the Money type.
+// If the value is not present, it returns an empty string.
+//
+// Returns:
+//   - string: The currency code if present, otherwise an empty string.
+func (m *Money) Currency() string {
+	if !m.present {
+		return ""
+	}
+	return m.currency
+}
+
+// Present checks if the Money type is present in the JSON payload.
+// It returns true if the value was provided in the JSON payload, otherwise false.
+//
+// Returns:
+//   - bool: True if the value is present, otherwise false.
+func (m *Money) Present() bool {
+	return m.present
+}
+
+// validateMoney checks that amount is a plain decimal number and currency is a known ISO 4217 code.
+func validateMoney(amount, currency string) error {
+	if !amountPattern.MatchString(amount) {
+		return fmt.Errorf("invalid money amount: %q", amount)
+	}
+	if _, ok := currencyCodes[currency]; !ok {
+		return fmt.Errorf("invalid currency code: %q", currency)
+	}
+	return nil
+}
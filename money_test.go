@@ -0,0 +1,72 @@
+package params
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney(t *testing.T) {
+	type result struct {
+		Price Money `json:"price"`
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		output   string
+		amount   string
+		currency string
+		present  bool
+		wantErr  bool
+	}{
+		{name: "quoted amount", input: `{"price":{"amount":"12.34","currency":"USD"}}`, amount: "12.34", currency: "USD", present: true},
+		{name: "bare amount", input: `{"price":{"amount":12.34,"currency":"EUR"}}`, output: `{"price":{"amount":"12.34","currency":"EUR"}}`, amount: "12.34", currency: "EUR", present: true},
+		{name: "exact precision", input: `{"price":{"amount":"0.1000000000000000055511151231257827","currency":"USD"}}`, amount: "0.1000000000000000055511151231257827", currency: "USD", present: true},
+		{name: "negative amount", input: `{"price":{"amount":"-5","currency":"JPY"}}`, amount: "-5", currency: "JPY", present: true},
+		{name: "null", input: `{"price":null}`, output: `{"price":null}`},
+		{name: "missing", input: `{}`, output: `{"price":null}`},
+		{name: "exponent amount", input: `{"price":{"amount":"1e3","currency":"USD"}}`, wantErr: true},
+		{name: "invalid amount", input: `{"price":{"amount":"12,34","currency":"USD"}}`, wantErr: true},
+		{name: "missing amount", input: `{"price":{"currency":"USD"}}`, wantErr: true},
+		{name: "unknown currency", input: `{"price":{"amount":"1","currency":"ABC"}}`, wantErr: true},
+		{name: "lowercase currency", input: `{"price":{"amount":"1","currency":"usd"}}`, wantErr: true},
+		{name: "missing currency", input: `{"price":{"amount":"1"}}`, wantErr: true},
+		{name: "not an object", input: `{"price":"12.34"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.output == "" {
+				tt.output = tt.input
+			}
+			var test result
+			err := json.Unmarshal([]byte(tt.input), &test)
+			if tt.wantErr {
+				require.Error(t, err, "Unmarshal should return an error")
+				require.False(t, test.Price.Present(), "Price should not be present")
+				return
+			}
+			require.NoError(t, err, "Unmarshal should not return an error")
+			require.Equal(t, tt.amount, test.Price.Amount(), "Amount mismatch")
+			require.Equal(t, tt.currency, test.Price.Currency(), "Currency mismatch")
+			require.Equal(t, tt.present, test.Price.Present(), "Present mismatch")
+
+			js, err := json.Marshal(test)
+			require.NoError(t, err, "Marshal should not return an error")
+			require.JSONEq(t, tt.output, string(js), "Marshalled JSON mismatch")
+		})
+	}
+}
+
+func TestMoney_Set(t *testing.T) {
+	var m Money
+	require.Error(t, m.Set("1.5", "XYZ"), "Set should reject unknown currency")
+	require.False(t, m.Present(), "Money should not be present after failed Set")
+
+	require.NoError(t, m.Set("1.50", "GBP"), "Set should accept valid money")
+	require.True(t, m.Present(), "Money should be present")
+	require.Equal(t, "1.50", m.Amount(), "Amount mismatch")
+	require.Equal(t, "GBP", m.Currency(), "Currency mismatch")
+}
@@ -0,0 +1,24 @@
+package params
+
+import "sync/atomic"
+
+// zeroWhenAbsent stores the inverse of the null-when-absent policy,
+// so that the zero value matches the documented default.
+var zeroWhenAbsent atomic.Bool
+
+// SetNullWhenAbsent sets the package-wide output policy for absent numeric values.
+// When enabled (the default), numeric types such as Int marshal to JSON null
+// if they are not present. When disabled, they marshal to their zero value
+// instead, e.g. 0 for Int. The setting is safe for concurrent use and affects
+// all values marshalled after the call.
+//
+// Parameters:
+//   - null: True to emit null for absent numeric values, false to emit zero.
+func SetNullWhenAbsent(null bool) {
+	zeroWhenAbsent.Store(!null)
+}
+
+// nullWhenAbsent reports whether absent numeric values marshal to null.
+func nullWhenAbsent() bool {
+	return !zeroWhenAbsent.Load()
+}
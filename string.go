@@ -2,20 +2,64 @@ package params
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
 )
 
+// maxPooledBufferSize caps the capacity of buffers returned to stringBufferPool,
+// so a single huge value does not stay pinned in memory.
+const maxPooledBufferSize = 64 << 10
+
+// stringBufferPool holds scratch buffers used by String.MarshalJSON.
+var stringBufferPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 64)
+		return &b
+	},
+}
+
+// internTable maps decoded values to their shared copy for String.SetIntern.
+var internTable sync.Map
+
+// internString returns the shared copy of str, storing it on first use.
+func internString(str string) string {
+	if v, ok := internTable.Load(str); ok {
+		return v.(string)
+	}
+	v, _ := internTable.LoadOrStore(str, str)
+	return v.(string)
+}
+
 // Structure for handling strings in JSON payloads
 // This structure allows for the presence of a string to be explicitly indicated,
 type String struct {
 	value   string // The actual string value
 	present bool   // Indicates if the string is present in the JSON payload
+	null    bool   // Indicates if the JSON payload contained an explicit null
+	empty   bool   // Marshals an empty value as null
+	max     int    // Maximum raw JSON length accepted by UnmarshalJSON, zero if unlimited
+	intern  bool   // Interns decoded values in the package-wide table
+	control bool   // Rejects decoded values containing control characters
+	coerce  bool   // Accepts JSON numbers and booleans as their literal text
+	ascii   bool   // Escapes all non-ASCII runes in MarshalJSON output
 }
 
 // UnmarshalJSON implements custom unmarshalling for the String type.
 // It handles cases where the string may be empty, null, or quoted.
 // If the string is empty or null, it sets Present to false and Value to an empty string.
+// A literal null is additionally remembered, so it can be marshalled back as null.
 // If the string is quoted, it removes the quotes and sets Present to true.
 // If the string is not quoted, it sets Present to true and retains the value as is.
+// Data longer than the limit set with SetMaxBytes is rejected before decoding.
+// With SetRejectControlChars enabled, values containing control characters are rejected.
+// JSON numbers and booleans are rejected unless SetCoerceScalars is enabled.
 // This allows for flexible handling of string values in JSON payloads.
 //
 // Parameters:
@@ -24,17 +68,36 @@ type String struct {
 // Returns:
 //   - error: An error if the unmarshalling fails, otherwise nil.
 func (s *String) UnmarshalJSON(data []byte) error {
-	if len(data) == 0 || string(data) == "null" {
+	s.null = string(data) == "null"
+	if len(data) == 0 || s.null {
 		s.value = ""
 		s.present = false
 		return nil
 	}
 
-	if err := json.Unmarshal(data, &s.value); err != nil {
+	if s.max > 0 && len(data) > s.max {
+		s.value = ""
+		s.present = false
+		return fmt.Errorf("string of %d bytes exceeds the maximum of %d bytes", len(data), s.max)
+	}
+
+	if s.coerce && isJSONScalar(data) {
+		s.value = string(data)
+	} else if err := json.Unmarshal(data, &s.value); err != nil {
 		s.value = ""
 		s.present = false
 		return err
 	}
+	if s.control {
+		if r, ok := findControlChar(s.value); ok {
+			s.value = ""
+			s.present = false
+			return fmt.Errorf("string contains control character %U", r)
+		}
+	}
+	if s.intern {
+		s.value = internString(s.value)
+	}
 	s.present = true
 
 	return nil
@@ -66,6 +129,74 @@ func (s *String) UnmarshalParam(param string) error {
 	return s.UnmarshalJSON([]byte(param))
 }
 
+// SetRejectControlChars enables or disables rejection of control characters.
+// When enabled, UnmarshalJSON returns an error naming the first offending code
+// point if the decoded value contains a control character other than tab or
+// newline, such as the escape character used by terminal sequences. This covers
+// the C0 and C1 ranges as defined by unicode.IsControl. It is disabled by default.
+//
+// Parameters:
+//   - reject: True to reject values containing control characters.
+func (s *String) SetRejectControlChars(reject bool) {
+	s.control = reject
+}
+
+// SetCoerceScalars enables or disables accepting JSON numbers and booleans.
+// When enabled, UnmarshalJSON stores such values as their literal JSON text,
+// so 123 becomes "123", 1.50 stays "1.50" and true becomes "true", for
+// loosely-typed clients that do not quote every string field. Objects and
+// arrays are still rejected. It is disabled by default.
+//
+// Parameters:
+//   - coerce: True to accept JSON numbers and booleans.
+func (s *String) SetCoerceScalars(coerce bool) {
+	s.coerce = coerce
+}
+
+// isJSONScalar reports whether data is a JSON number, true or false.
+func isJSONScalar(data []byte) bool {
+	if string(data) == "true" || string(data) == "false" {
+		return true
+	}
+	return (data[0] == '-' || data[0] >= '0' && data[0] <= '9') && json.Valid(data)
+}
+
+// findControlChar returns the first control character in str other than tab and newline.
+func findControlChar(str string) (rune, bool) {
+	for _, r := range str {
+		if r != '\t' && r != '\n' && unicode.IsControl(r) {
+			return r, true
+		}
+	}
+	return 0, false
+}
+
+// SetIntern enables or disables interning of decoded values.
+// When enabled, UnmarshalJSON looks each decoded value up in a package-wide
+// table, backed by a sync.Map and safe for concurrent use, so repeated values
+// such as country codes share one backing array instead of one allocation each.
+// This trades a map lookup per decode for lower heap usage. The table is never
+// pruned, so it should only be used for low-cardinality values; interning
+// unbounded input such as free text grows it without limit.
+// It is disabled by default.
+//
+// Parameters:
+//   - intern: True to intern decoded values.
+func (s *String) SetIntern(intern bool) {
+	s.intern = intern
+}
+
+// SetMaxBytes limits the raw size of the JSON data UnmarshalJSON accepts.
+// Data longer than n bytes, including quotes and escape sequences, is rejected
+// before the value is decoded, so oversized payloads are never allocated as strings.
+// A limit of zero or less disables the check, which is the default.
+//
+// Parameters:
+//   - n: The maximum number of raw bytes, or zero for no limit.
+func (s *String) SetMaxBytes(n int) {
+	s.max = n
+}
+
 // Set sets the value of the String type and marks it as present.
 // This method updates the Value field with the provided string and sets Present to true.
 //
@@ -74,18 +205,66 @@ func (s *String) UnmarshalParam(param string) error {
 func (s *String) Set(value string) {
 	s.value = value
 	s.present = true
+	s.null = false
 }
 
 // MarshalJSON implements custom marshalling for the String type.
 // It converts the String type to a JSON string representation.
-// If the string is not present, it returns an empty JSON string.
+// If the string was an explicit null, it returns null.
+// If the string is not present otherwise, it returns an empty JSON string.
 // If the string is present, it returns the value wrapped in quotes.
+// With SetEmptyAsNull enabled, an empty value returns null instead of "".
+// The value is escaped into a pooled scratch buffer to reduce allocations,
+// producing the same bytes as json.Marshal unless SetASCIIOnly is enabled.
 //
 // Returns:
 //   - []byte: The JSON representation of the String type.
 //   - error: An error if the marshalling fails, otherwise nil.
 func (s String) MarshalJSON() ([]byte, error) {
-	return json.Marshal(s.Value())
+	value := s.Value()
+	if s.null || (s.empty && value == "") {
+		return []byte("null"), nil
+	}
+
+	if !s.ascii && !utf8.ValidString(value) {
+		// The replacement of invalid UTF-8 differs between encoding/json versions,
+		// so leave it to the standard library to stay byte-identical with it.
+		return json.Marshal(value)
+	}
+
+	bp := stringBufferPool.Get().(*[]byte)
+	buf := appendJSONString((*bp)[:0], value, s.ascii)
+
+	out := make([]byte, len(buf))
+	copy(out, buf)
+
+	if cap(buf) <= maxPooledBufferSize {
+		*bp = buf
+		stringBufferPool.Put(bp)
+	}
+
+	return out, nil
+}
+
+// SetEmptyAsNull enables or disables null output for empty strings.
+// When enabled, MarshalJSON emits null for a present empty string, and for
+// an absent one, instead of "". This suits schemas that treat empty and null
+// identically. It is disabled by default and does not affect unmarshalling.
+//
+// Parameters:
+//   - empty: True to marshal empty strings as null.
+func (s *String) SetEmptyAsNull(empty bool) {
+	s.empty = empty
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the raw string value, or empty bytes if the string is not present.
+//
+// Returns:
+//   - []byte: The text representation of the String type.
+//   - error: An error if the marshalling fails, otherwise nil.
+func (s String) MarshalText() ([]byte, error) {
+	return []byte(s.Value()), nil
 }
 
 // GetJSON returns the JSON representation of the String type.
@@ -123,3 +302,283 @@ func (s *String) Value() string {
 	}
 	return s.value
 }
+
+// SetASCIIOnly enables or disables ASCII-only output.
+// When enabled, MarshalJSON escapes every non-ASCII rune as \uXXXX, using a
+// surrogate pair for runes outside the Basic Multilingual Plane, for legacy
+// consumers that cannot parse raw UTF-8. It is disabled by default.
+//
+// Parameters:
+//   - ascii: True to escape all non-ASCII runes.
+func (s *String) SetASCIIOnly(ascii bool) {
+	s.ascii = ascii
+}
+
+// MustValue is like Value but panics if the string is not present.
+// Like Int.MustValue, it is meant for tests, not for request-handling code.
+//
+// Returns:
+//   - string: The value of the String type.
+func (s *String) MustValue() string {
+	if !s.present {
+		panic("params: MustValue called on an absent String")
+	}
+	return s.value
+}
+
+// appendJSONString appends s to dst as a quoted JSON string.
+// For valid UTF-8 the output is byte-identical to json.Marshal: HTML characters,
+// control characters, U+2028 and U+2029 are escaped. Invalid UTF-8 is replaced with \ufffd.
+// If ascii is set, all other non-ASCII runes are escaped too, using surrogate pairs above U+FFFF.
+func appendJSONString(dst []byte, s string, ascii bool) []byte {
+	const hex = "0123456789abcdef"
+
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if b := s[i]; b < utf8.RuneSelf {
+			if b >= ' ' && b != '"' && b != '\\' && b != '<' && b != '>' && b != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch b {
+			case '\\', '"':
+				dst = append(dst, '\\', b)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[b>>4], hex[b&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		c, size := utf8.DecodeRuneInString(s[i:])
+		if c == utf8.RuneError && size == 1 {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+		if ascii {
+			dst = append(dst, s[start:i]...)
+			if r1, r2 := utf16.EncodeRune(c); r1 != utf8.RuneError {
+				dst = appendUnicodeEscape(dst, r1)
+				c = r2
+			}
+			dst = appendUnicodeEscape(dst, c)
+			i += size
+			start = i
+			continue
+		}
+		if c == '\u2028' || c == '\u2029' {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[c&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, s[start:]...)
+	return append(dst, '"')
+}
+
+// appendUnicodeEscape appends r as a \uXXXX escape, where r must not exceed U+FFFF.
+func appendUnicodeEscape(dst []byte, r rune) []byte {
+	const hex = "0123456789abcdef"
+	return append(dst, '\\', 'u', hex[r>>12&0xF], hex[r>>8&0xF], hex[r>>4&0xF], hex[r&0xF])
+}
+
+// Null checks if the String type was an explicit null in the JSON payload.
+// It distinguishes {"field":null} from an omitted field, both of which are not present.
+//
+// Returns:
+//   - bool: True if the literal null was provided, otherwise false.
+func (s *String) Null() bool {
+	return s.null
+}
+
+// IsZero reports whether the String type was omitted from the JSON payload.
+// It allows the `omitzero` struct tag option to drop omitted fields on marshal
+// while explicit nulls are still emitted as null.
+//
+// Returns:
+//   - bool: True if the string is neither present nor an explicit null, otherwise false.
+func (s *String) IsZero() bool {
+	return !s.present && !s.null
+}
+
+// Hash returns a stable 64-bit hash of the String type for cache keys and sharding.
+// The hash is FNV-1a 64 over the byte 0x01 followed by the UTF-8 bytes of the
+// value, so it is reproducible across processes and platforms. An absent String,
+// including an explicit null, hashes the single byte 0x00 instead, so it never
+// collides with a present empty string.
+//
+// Returns:
+//   - uint64: The hash of the string.
+func (s *String) Hash() uint64 {
+	if !s.present {
+		return hashValue(false, nil)
+	}
+	return hashValue(true, []byte(s.value))
+}
+
+// String implements the fmt.Stringer interface.
+// It returns "null" if the string is not present, otherwise the raw value.
+//
+// Returns:
+//   - string: The string representation of the String type.
+func (s String) String() string {
+	if !s.present {
+		return "null"
+	}
+	return s.value
+}
+
+// ToLower returns a copy of the String with the value mapped to lower case.
+// Casing is Unicode-aware as in strings.ToLower. The present flag is preserved,
+// so an absent String stays absent.
+//
+// Returns:
+//   - String: A new String with the lower-cased value.
+func (s *String) ToLower() String {
+	t := *s
+	if t.present {
+		t.value = strings.ToLower(t.value)
+	}
+	return t
+}
+
+// ToUpper returns a copy of the String with the value mapped to upper case.
+// Casing is Unicode-aware as in strings.ToUpper. The present flag is preserved,
+// so an absent String stays absent.
+//
+// Returns:
+//   - String: A new String with the upper-cased value.
+func (s *String) ToUpper() String {
+	t := *s
+	if t.present {
+		t.value = strings.ToUpper(t.value)
+	}
+	return t
+}
+
+// IsBlank reports whether the string is absent or contains only whitespace.
+// Unlike Present, a present whitespace-only string is considered blank.
+//
+// Returns:
+//   - bool: True if the string is absent or blank, otherwise false.
+func (s *String) IsBlank() bool {
+	return strings.TrimSpace(s.Value()) == ""
+}
+
+// Truncate returns a copy of the String cut to at most maxRunes runes.
+// If the value is cut, ellipsis is appended after the kept runes, so the
+// result may be longer than maxRunes by the length of ellipsis. Counting is
+// rune-aware, so multibyte characters are never split. A negative maxRunes
+// is treated as zero. The present flag is preserved, so an absent String stays absent.
+//
+// Parameters:
+//   - maxRunes: The maximum number of runes to keep.
+//   - ellipsis: The suffix appended when the value is cut, e.g. "…".
+//
+// Returns:
+//   - String: A new String with the truncated value.
+func (s *String) Truncate(maxRunes int, ellipsis string) String {
+	t := *s
+	if !t.present {
+		return t
+	}
+
+	maxRunes = max(maxRunes, 0)
+	count := 0
+	for idx := range t.value {
+		if count == maxRunes {
+			t.value = t.value[:idx] + ellipsis
+			break
+		}
+		count++
+	}
+
+	return t
+}
+
+// ReplaceAll returns a copy of the String with every match of re replaced by repl.
+// The replacement follows regexp.Regexp.ReplaceAllString, so repl may reference
+// submatches with $1 or ${name}. The present flag is preserved, so an absent
+// String stays absent.
+//
+// Parameters:
+//   - re: The compiled regular expression to match.
+//   - repl: The replacement template.
+//
+// Returns:
+//   - String: A new String with the replacements applied.
+func (s *String) ReplaceAll(re *regexp.Regexp, repl string) String {
+	t := *s
+	if t.present {
+		t.value = re.ReplaceAllString(t.value, repl)
+	}
+	return t
+}
+
+// Normalize returns a copy of the String with the value converted to the
+// Unicode normalization form, e.g. norm.NFC, so composed and decomposed
+// spellings of the same text compare equal. The present flag is preserved,
+// so an absent String stays absent.
+//
+// Parameters:
+//   - form: The normalization form to apply.
+//
+// Returns:
+//   - String: A new String with the normalized value.
+func (s *String) Normalize(form norm.Form) String {
+	t := *s
+	if t.present {
+		t.value = form.String(t.value)
+	}
+	return t
+}
+
+// Ptr returns a pointer to a copy of the string value, or nil if it is not present.
+// It converts the String type to the pointer-based optional form used by many generated clients.
+//
+// Returns:
+//   - *string: A pointer to the value if present, otherwise nil.
+func (s *String) Ptr() *string {
+	if !s.present {
+		return nil
+	}
+	v := s.value
+	return &v
+}
+
+// StringFromPtr creates a String from a pointer-based optional value.
+// A nil pointer yields an absent String, otherwise the pointed-to value is copied and marked present.
+// StringFromPtr(v.Ptr()) keeps the value and presence of v, but not its options, such as
+// SetEmptyAsNull, nor an explicit null: Ptr returns nil for it, so the round trip
+// yields an absent String that marshals as "" instead of null.
+//
+// Parameters:
+//   - p: The pointer to convert.
+//
+// Returns:
+//   - String: The converted String.
+func StringFromPtr(p *string) String {
+	var v String
+	if p != nil {
+		v.Set(*p)
+	}
+	return v
+}
@@ -2,9 +2,14 @@ package params
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
+	"unsafe"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/unicode/norm"
 )
 
 func TestString(t *testing.T) {
@@ -56,7 +61,7 @@ func TestString(t *testing.T) {
 		{
 			name:    "Null JSON",
 			input:   `{"field":null,"value":null}`,
-			output:  `{"field":"","value":""}`,
+			output:  `{"field":null,"value":null}`,
 			want:    Test{Field: want{Present: false}, Value: want{Present: false}},
 			wantErr: false,
 		},
@@ -117,3 +122,447 @@ func TestString(t *testing.T) {
 		})
 	}
 }
+
+func TestString_MarshalJSONMatchesStdlib(t *testing.T) {
+	inputs := []string{
+		"",
+		"plain",
+		`quote " and backslash \`,
+		"<script>&amp;</script>",
+		"\b\f\n\r\t\x00\x01\x1f\x7f",
+		"unicode привет 世界 🎉",
+		"line paragraph ",
+		"invalid \xff\xfe utf8 \xc3",
+	}
+
+	for _, input := range inputs {
+		var s String
+		s.Set(input)
+		got, err := s.MarshalJSON()
+		require.NoError(t, err, "MarshalJSON should not return an error")
+		want, err := json.Marshal(input)
+		require.NoError(t, err, "json.Marshal should not return an error")
+		require.Equal(t, string(want), string(got), "MarshalJSON output should match json.Marshal for %q", input)
+	}
+}
+
+func BenchmarkString_MarshalJSON(b *testing.B) {
+	var s String
+	s.Set("benchmark <value> with \"escapes\" and unicode: привет")
+
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_, _ = s.MarshalJSON()
+		}
+	})
+	b.Run("json.Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_, _ = json.Marshal(s.Value())
+		}
+	})
+}
+
+func TestString_ExplicitNull(t *testing.T) {
+	type patch struct {
+		Field String `json:"field,omitzero"`
+		Value String `json:"value,omitzero"`
+	}
+
+	tests := []struct {
+		name   string
+		input  string
+		output string
+		null   bool
+	}{
+		{name: "explicit null kept", input: `{"field":null}`, output: `{"field":null}`, null: true},
+		{name: "omitted field stays omitted", input: `{}`, output: `{}`},
+		{name: "value kept", input: `{"field":"a"}`, output: `{"field":"a"}`},
+		{name: "empty string kept", input: `{"field":""}`, output: `{"field":""}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var test patch
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &test), "Unmarshal should not return an error")
+			require.Equal(t, tt.null, test.Field.Null(), "Null mismatch")
+			require.False(t, test.Value.Null(), "omitted field should not be null")
+
+			js, err := json.Marshal(test)
+			require.NoError(t, err, "Marshal should not return an error")
+			require.JSONEq(t, tt.output, string(js), "Marshalled JSON mismatch")
+		})
+	}
+
+	var s String
+	require.NoError(t, s.UnmarshalJSON([]byte("null")), "UnmarshalJSON should not return an error")
+	s.Set("value")
+	require.False(t, s.Null(), "Set should clear the explicit null")
+}
+
+func TestString_Hash(t *testing.T) {
+	var absent, empty, abc, other String
+	empty.Set("")
+	abc.Set("abc")
+	other.Set("abc")
+
+	require.Equal(t, uint64(0xaf63bd4c8601b7df), absent.Hash(), "absent hash should be FNV-1a of 0x00")
+	require.Equal(t, uint64(0xaf63bc4c8601b62c), empty.Hash(), "hash of an empty string should be FNV-1a of 0x01")
+	require.Equal(t, uint64(0xca907677e91e9e04), abc.Hash(), "hash of abc should be stable")
+	require.NotEqual(t, absent.Hash(), empty.Hash(), "absent and empty should hash differently")
+	require.Equal(t, abc.Hash(), other.Hash(), "equal values should hash equally")
+}
+
+func TestString_String(t *testing.T) {
+	var s String
+	require.Equal(t, "null", s.String(), "absent String should print as null")
+	s.Set("")
+	require.Equal(t, "", s.String(), "present empty String should print empty")
+	s.Set("value")
+	require.Equal(t, "value", fmt.Sprintf("%v", s), "%%v should use String()")
+}
+
+func TestString_Case(t *testing.T) {
+	var absent String
+	lower := absent.ToLower()
+	upper := absent.ToUpper()
+	require.False(t, lower.Present(), "absent String should stay absent")
+	require.False(t, upper.Present(), "absent String should stay absent")
+
+	var s String
+	s.Set("Ärger Straße")
+	lower = s.ToLower()
+	upper = s.ToUpper()
+	require.True(t, lower.Present(), "lower-cased String should be present")
+	require.Equal(t, "ärger straße", lower.Value(), "ToLower mismatch")
+	require.Equal(t, "ÄRGER STRAßE", upper.Value(), "ToUpper mismatch")
+	require.Equal(t, "Ärger Straße", s.Value(), "original String should not change")
+}
+
+func TestString_IsBlank(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		present bool
+		want    bool
+	}{
+		{name: "absent", want: true},
+		{name: "empty", value: "", present: true, want: true},
+		{name: "whitespace", value: " \t\n", present: true, want: true},
+		{name: "text", value: " a ", present: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s String
+			if tt.present {
+				s.Set(tt.value)
+			}
+			require.Equal(t, tt.want, s.IsBlank(), "IsBlank mismatch")
+			require.Equal(t, tt.present, s.Present(), "Present mismatch")
+		})
+	}
+}
+
+func TestString_Truncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		present  bool
+		maxRunes int
+		want     string
+	}{
+		{name: "absent", maxRunes: 3, want: ""},
+		{name: "shorter than limit", value: "abc", present: true, maxRunes: 5, want: "abc"},
+		{name: "equal to limit", value: "abcde", present: true, maxRunes: 5, want: "abcde"},
+		{name: "longer than limit", value: "abcdef", present: true, maxRunes: 3, want: "abc…"},
+		{name: "multibyte", value: "привет мир", present: true, maxRunes: 6, want: "привет…"},
+		{name: "emoji", value: "🎉🎉🎉", present: true, maxRunes: 1, want: "🎉…"},
+		{name: "zero limit", value: "abc", present: true, maxRunes: 0, want: "…"},
+		{name: "negative limit", value: "abc", present: true, maxRunes: -1, want: "…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s String
+			if tt.present {
+				s.Set(tt.value)
+			}
+			got := s.Truncate(tt.maxRunes, "…")
+			require.Equal(t, tt.present, got.Present(), "Present mismatch")
+			require.Equal(t, tt.want, got.Value(), "Truncate mismatch")
+		})
+	}
+}
+
+func TestString_ReplaceAll(t *testing.T) {
+	controls := regexp.MustCompile(`[\x00-\x1f]`)
+
+	var absent String
+	got := absent.ReplaceAll(controls, "")
+	require.False(t, got.Present(), "absent String should stay absent")
+
+	var s String
+	s.Set("a\tb\nc")
+	got = s.ReplaceAll(controls, "")
+	require.True(t, got.Present(), "replaced String should be present")
+	require.Equal(t, "abc", got.Value(), "ReplaceAll mismatch")
+	require.Equal(t, "a\tb\nc", s.Value(), "original String should not change")
+
+	s.Set("john smith")
+	got = s.ReplaceAll(regexp.MustCompile(`(\w+) (\w+)`), "$2, $1")
+	require.Equal(t, "smith, john", got.Value(), "submatch expansion mismatch")
+}
+
+func TestString_Normalize(t *testing.T) {
+	const composed, decomposed = "caf\u00e9", "cafe\u0301"
+
+	var absent String
+	got := absent.Normalize(norm.NFC)
+	require.False(t, got.Present(), "absent String should stay absent")
+
+	var s String
+	s.Set(decomposed)
+	got = s.Normalize(norm.NFC)
+	require.True(t, got.Present(), "normalized String should be present")
+	require.Equal(t, composed, got.Value(), "NFC mismatch")
+	require.Equal(t, decomposed, s.Value(), "original String should not change")
+
+	s.Set(composed)
+	got = s.Normalize(norm.NFD)
+	require.Equal(t, decomposed, got.Value(), "NFD mismatch")
+}
+
+func TestString_Ptr(t *testing.T) {
+	var absent String
+	require.Nil(t, absent.Ptr(), "absent String should return nil")
+	fromNil := StringFromPtr(nil)
+	require.False(t, fromNil.Present(), "nil pointer should yield an absent String")
+
+	var v String
+	v.Set("value")
+	p := v.Ptr()
+	require.NotNil(t, p, "present String should return a pointer")
+	require.Equal(t, "value", *p, "Ptr value mismatch")
+
+	back := StringFromPtr(p)
+	require.True(t, back.Present(), "StringFromPtr should mark the value present")
+	require.Equal(t, v.Value(), back.Value(), "round trip mismatch")
+	roundTrip := StringFromPtr(absent.Ptr())
+	require.False(t, roundTrip.Present(), "absent round trip should stay absent")
+
+	var null String
+	require.NoError(t, null.UnmarshalJSON([]byte("null")), "UnmarshalJSON should not return an error")
+	require.Nil(t, null.Ptr(), "explicit null should return nil")
+	dropped := StringFromPtr(null.Ptr())
+	require.False(t, dropped.Null(), "the round trip should drop the explicit null")
+	got, err := dropped.MarshalJSON()
+	require.NoError(t, err, "MarshalJSON should not return an error")
+	require.Equal(t, `""`, string(got), "the round trip should marshal as an empty string")
+}
+
+func TestString_SetEmptyAsNull(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		present bool
+		empty   bool
+		want    string
+	}{
+		{name: "present empty by default", present: true, want: `""`},
+		{name: "present empty as null", present: true, empty: true, want: `null`},
+		{name: "absent as null", empty: true, want: `null`},
+		{name: "non-empty unaffected", value: "a", present: true, empty: true, want: `"a"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s String
+			s.SetEmptyAsNull(tt.empty)
+			if tt.present {
+				s.Set(tt.value)
+			}
+			got, err := json.Marshal(s)
+			require.NoError(t, err, "MarshalJSON should not return an error")
+			require.Equal(t, tt.want, string(got), "MarshalJSON mismatch")
+		})
+	}
+}
+
+func TestString_SetMaxBytes(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		max     int
+		value   string
+		wantErr bool
+	}{
+		{name: "unlimited", data: `"abcdef"`, value: "abcdef"},
+		{name: "within limit", data: `"abc"`, max: 5, value: "abc"},
+		{name: "over limit", data: `"abcd"`, max: 5, wantErr: true},
+		{name: "escapes count raw", data: `"\u0041"`, max: 5, wantErr: true},
+		{name: "null unaffected", data: `null`, max: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s String
+			s.Set("previous")
+			s.SetMaxBytes(tt.max)
+			err := s.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err, "UnmarshalJSON should return an error")
+				require.False(t, s.Present(), "String should not be present")
+				require.Equal(t, "", s.Value(), "Value should be reset")
+				return
+			}
+			require.NoError(t, err, "UnmarshalJSON should not return an error")
+			require.Equal(t, tt.value, s.Value(), "Value mismatch")
+		})
+	}
+}
+
+func TestString_MustValue(t *testing.T) {
+	var s String
+	require.NoError(t, s.UnmarshalJSON([]byte("null")), "UnmarshalJSON should not return an error")
+	require.Panics(t, func() { s.MustValue() }, "MustValue should panic on null")
+
+	s.Set("")
+	require.Equal(t, "", s.MustValue(), "MustValue mismatch")
+}
+
+func TestString_SetIntern(t *testing.T) {
+	decode := func(value string, intern bool) String {
+		var s String
+		s.SetIntern(intern)
+		require.NoError(t, s.UnmarshalJSON(fmt.Appendf(nil, "%q", value)), "UnmarshalJSON should not return an error")
+		return s
+	}
+
+	a, b := decode("intern-test-DE", true), decode("intern-test-DE", true)
+	require.Equal(t, "intern-test-DE", a.Value(), "Value mismatch")
+	require.Same(t, unsafe.StringData(a.Value()), unsafe.StringData(b.Value()), "interned values should share storage")
+	_, ok := internTable.Load("intern-test-DE")
+	require.True(t, ok, "interned value should be stored in the table")
+
+	plain := decode("intern-test-FR", false)
+	require.Equal(t, "intern-test-FR", plain.Value(), "Value mismatch")
+	_, ok = internTable.Load("intern-test-FR")
+	require.False(t, ok, "values should only be stored when interning is enabled")
+}
+
+func TestString_SetRejectControlChars(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		reject  bool
+		wantErr string
+	}{
+		{name: "escape allowed by default", data: `"\u001b[31mred"`},
+		{name: "escape rejected", data: `"\u001b[31mred"`, reject: true, wantErr: "U+001B"},
+		{name: "carriage return rejected", data: `"a\rb"`, reject: true, wantErr: "U+000D"},
+		{name: "C1 control rejected", data: `"a\u0085b"`, reject: true, wantErr: "U+0085"},
+		{name: "tab and newline allowed", data: `"a\tb\nc"`, reject: true},
+		{name: "unicode text allowed", data: `"Grüße 🎉"`, reject: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s String
+			s.SetRejectControlChars(tt.reject)
+			err := s.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr, "error should name the code point")
+				require.False(t, s.Present(), "String should not be present")
+				require.Equal(t, "", s.Value(), "Value should be reset")
+				return
+			}
+			require.NoError(t, err, "UnmarshalJSON should not return an error")
+			require.True(t, s.Present(), "String should be present")
+		})
+	}
+}
+
+func TestString_SetCoerceScalars(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		coerce  bool
+		value   string
+		wantErr bool
+	}{
+		{name: "number rejected by default", data: `123`, wantErr: true},
+		{name: "boolean rejected by default", data: `true`, wantErr: true},
+		{name: "integer", data: `123`, coerce: true, value: "123"},
+		{name: "decimal keeps its text", data: `-1.50`, coerce: true, value: "-1.50"},
+		{name: "exponent keeps its text", data: `1e3`, coerce: true, value: "1e3"},
+		{name: "true", data: `true`, coerce: true, value: "true"},
+		{name: "false", data: `false`, coerce: true, value: "false"},
+		{name: "string unchanged", data: `"abc"`, coerce: true, value: "abc"},
+		{name: "object rejected", data: `{"a":1}`, coerce: true, wantErr: true},
+		{name: "array rejected", data: `[1]`, coerce: true, wantErr: true},
+		{name: "invalid number rejected", data: `12abc`, coerce: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s String
+			s.SetCoerceScalars(tt.coerce)
+			err := s.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err, "UnmarshalJSON should return an error")
+				require.False(t, s.Present(), "String should not be present")
+				return
+			}
+			require.NoError(t, err, "UnmarshalJSON should not return an error")
+			require.Equal(t, tt.value, s.Value(), "Value mismatch")
+			require.True(t, s.Present(), "String should be present")
+		})
+	}
+
+	t.Run("struct field", func(t *testing.T) {
+		var dst struct {
+			Field String `json:"field"`
+		}
+		dst.Field.SetCoerceScalars(true)
+		require.NoError(t, json.Unmarshal([]byte(`{"field": 123}`), &dst), "json.Unmarshal should not return an error")
+		require.Equal(t, "123", dst.Field.Value(), "Value mismatch")
+	})
+}
+
+func TestString_SetASCIIOnly(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "html still escaped", value: "plain <b>", want: `"plain \u003cb\u003e"`},
+		{name: "latin", value: "Gr\u00fc\u00dfe", want: `"Gr\u00fc\u00dfe"`},
+		{name: "cyrillic", value: "\u043f\u0440\u0438", want: `"\u043f\u0440\u0438"`},
+		{name: "surrogate pair", value: "\U0001f389", want: `"\ud83c\udf89"`},
+		{name: "line separator", value: "a\u2028b", want: `"a\u2028b"`},
+		{name: "invalid utf8", value: "a\xffb", want: `"a\ufffdb"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s String
+			s.SetASCIIOnly(true)
+			s.Set(tt.value)
+			got, err := s.MarshalJSON()
+			require.NoError(t, err, "MarshalJSON should not return an error")
+			require.Equal(t, tt.want, string(got), "MarshalJSON mismatch")
+
+			var back string
+			require.NoError(t, json.Unmarshal(got, &back), "output should be valid JSON")
+			require.Equal(t, strings.ToValidUTF8(tt.value, "\ufffd"), back, "output should decode to the value")
+		})
+	}
+
+	var s String
+	s.Set("Gr\u00fc\u00dfe")
+	got, err := s.MarshalJSON()
+	require.NoError(t, err, "MarshalJSON should not return an error")
+	require.Equal(t, "\"Gr\u00fc\u00dfe\"", string(got), "UTF-8 should be emitted by default")
+}
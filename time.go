@@ -2,7 +2,9 @@ package params
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -13,14 +15,47 @@ var timeLayouts = []string{
 	"2006-01-02T15:04:05",     // 2025-09-09T13:20:25
 }
 
+// now returns the current time. It is a variable so tests can fix the clock.
+var now = time.Now
+
+// millisLayout is RFC3339 with exactly three fractional digits.
+const millisLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// nanosLayout is RFC3339 with exactly nine fractional digits.
+const nanosLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// outputFormats maps the names accepted by SetOutputFormat to their layouts.
+var outputFormats = map[string]string{
+	"RFC822":  time.RFC822,
+	"RFC850":  time.RFC850,
+	"RFC1123": time.RFC1123,
+}
+
 // Time is a wrapper around time. Time that supports null values and multiple JSON formats.
 type Time struct {
-	value   time.Time // Value holds the actual time value
-	present bool      // Present indicates if the time is present or not
+	value      time.Time     // Value holds the actual time value
+	present    bool          // Present indicates if the time is present or not
+	zeroAsNull bool          // ZeroAsNull marshals a present zero time as null
+	valid      bool          // Valid indicates if the present value was parsed successfully
+	lenient    bool          // Lenient marks unparsable values invalid instead of returning an error
+	cache      bool          // Cache tries the last successful layout first
+	lastLayout int           // LastLayout is the index of the last successful layout plus one, zero if none
+	minTime    time.Time     // MinTime is the earliest accepted time, zero if unbounded
+	maxTime    time.Time     // MaxTime is the latest accepted time, zero if unbounded
+	millis     bool          // Millis always marshals exactly three fractional digits
+	local      bool          // Local converts the time to time.Local before marshalling
+	null       string        // Null is the literal MarshalJSON emits instead of null, empty for the default
+	nanos      bool          // Nanos always marshals nine fractional digits instead of trimming trailing zeros
+	dateOnly   bool          // DateOnly marshals only the date component
+	epoch      time.Duration // Epoch marshals the time as a bare Unix timestamp in this unit, zero if disabled
+	scanMillis bool          // ScanMillis reads integer Scan sources as Unix milliseconds instead of seconds
+	format     string        // Format is the layout selected with SetOutputFormat, empty if unset
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
 // It supports multiple time formats and null values.
+// In lenient mode a value that matches no layout is marked present but
+// not valid instead of returning an error.
 //
 // Parameters:
 //   - data: JSON data to unmarshal.
@@ -29,26 +64,117 @@ type Time struct {
 //   - error: An error if unmarshaling fails, otherwise nil.
 func (dst *Time) UnmarshalJSON(data []byte) error {
 	dst.value = time.Time{}
+	dst.valid = false
 	if len(data) == 0 || string(data) == "null" {
 		dst.present = false
 		return nil
 	}
 
+	dst.present = true
+
 	if string(data) == `""` {
-		dst.present = true
+		// An empty string is the zero time, which must respect the bounds as well.
+		if err := dst.checkBounds(time.Time{}); err != nil {
+			dst.present = false
+			return err
+		}
+		dst.valid = true
 		return nil
 	}
 
-	dst.present = true
-
-	for _, layout := range timeLayouts {
-		if t, err := time.Parse(layout, strings.Trim(string(data), `"`)); err == nil {
-			dst.value = t
+	t, ok := dst.parseEpoch(data)
+	if !ok {
+		t, ok = dst.parse(strings.Trim(string(data), `"`), nil)
+	}
+	if !ok {
+		if dst.lenient {
 			return nil
 		}
+		return fmt.Errorf("invalid time format: %s", string(data))
+	}
+
+	if err := dst.checkBounds(t); err != nil {
+		dst.present = false
+		return err
+	}
+
+	dst.value = t
+	dst.valid = true
+
+	return nil
+}
+
+// parse tries the known layouts on str, starting with the cached layout if enabled,
+// then the layout selected by the output options, so configured output round-trips,
+// and then the parsers added with RegisterTimeParser. Layouts without a zone are read in loc.
+// A nil loc keeps the semantics of time.Parse, which reads such layouts as UTC and
+// resolves zone abbreviations known to time.Local to their offset there.
+func (dst *Time) parse(str string, loc *time.Location) (time.Time, bool) {
+	if dst.cache && dst.lastLayout > 0 {
+		if t, err := parseLayout(timeLayouts[dst.lastLayout-1], str, loc); err == nil {
+			return t, true
+		}
 	}
 
-	return fmt.Errorf("invalid time format: %s", string(data))
+	for idx, layout := range timeLayouts {
+		if dst.cache && idx == dst.lastLayout-1 {
+			continue // Already tried above
+		}
+		if t, err := parseLayout(layout, str, loc); err == nil {
+			if dst.cache {
+				dst.lastLayout = idx + 1
+			}
+			return t, true
+		}
+	}
+
+	if layout := dst.outputLayout(); layout != "" {
+		if t, err := parseLayout(layout, str, loc); err == nil {
+			return t, true
+		}
+	}
+
+	return parseRegistered(str)
+}
+
+// parseLayout parses str with layout using time.ParseInLocation, or time.Parse if loc is nil.
+func parseLayout(layout, str string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return time.Parse(layout, str)
+	}
+	return time.ParseInLocation(layout, str, loc)
+}
+
+// parseEpoch decodes a bare JSON integer as a Unix timestamp in the unit set with SetEpochOutput.
+// It reports false if epoch output is disabled or data is not an integer.
+func (dst *Time) parseEpoch(data []byte) (time.Time, bool) {
+	if dst.epoch == 0 || data[0] == '"' {
+		return time.Time{}, false
+	}
+	n, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	switch dst.epoch {
+	case time.Second:
+		return time.Unix(n, 0).UTC(), true
+	case time.Millisecond:
+		return time.UnixMilli(n).UTC(), true
+	case time.Microsecond:
+		return time.UnixMicro(n).UTC(), true
+	}
+	return time.Unix(0, n).UTC(), true
+}
+
+// checkBounds returns an error if t falls outside the bounds configured with SetBounds.
+func (dst *Time) checkBounds(t time.Time) error {
+	if !dst.minTime.IsZero() && t.Before(dst.minTime) {
+		return fmt.Errorf("time %s is before the minimum allowed %s", t.Format(time.RFC3339Nano), dst.minTime.Format(time.RFC3339Nano))
+	}
+	if !dst.maxTime.IsZero() && t.After(dst.maxTime) {
+		return fmt.Errorf("time %s is after the maximum allowed %s", t.Format(time.RFC3339Nano), dst.maxTime.Format(time.RFC3339Nano))
+	}
+	return nil
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
@@ -78,16 +204,319 @@ func (dst *Time) UnmarshalParam(param string) error {
 }
 
 // MarshalJSON implements the json.Marshaler interface.
-// It returns "null" if the time is not present.
+// It returns "null" if the time is not present, or if it is zero and
+// SetZeroAsNull is enabled. The literal can be changed with SetNullLiteral.
+// With SetEpochOutput enabled, the time is emitted as a bare integer instead of a string.
+//
+// When several output options are set, they take precedence in this order:
+// epoch output, date-only output, the named format set with SetOutputFormat,
+// layout options (SetMillisOutput, then SetTrimFractionalZeros), and finally
+// the default RFC 3339 output.
 //
 // Returns:
 //   - []byte: JSON representation of the time.
 //   - error: An error if marshaling fails, otherwise nil.
-func (dst *Time) MarshalJSON() ([]byte, error) {
-	if !dst.present {
+func (dst Time) MarshalJSON() ([]byte, error) {
+	if dst.marshalNull() {
+		if dst.null != "" {
+			return []byte(dst.null), nil
+		}
 		return []byte("null"), nil
 	}
-	return dst.value.MarshalJSON()
+	if dst.epoch != 0 {
+		return dst.MarshalText()
+	}
+	text, err := dst.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, len(text)+2)
+	b = append(b, '"')
+	b = append(b, text...)
+	return append(b, '"'), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the same representation as MarshalJSON without quotes,
+// which makes the type usable in CSV exports and text-based encoders such as
+// structured loggers. It has a value receiver, like MarshalJSON, so that
+// Time values are rendered as well as pointers.
+// If the time would marshal as JSON null, it returns empty bytes.
+//
+// Returns:
+//   - []byte: Text representation of the time.
+//   - error: An error if marshaling fails, otherwise nil.
+func (dst Time) MarshalText() ([]byte, error) {
+	if dst.marshalNull() {
+		return []byte{}, nil
+	}
+
+	switch dst.epoch {
+	case time.Second:
+		return strconv.AppendInt(nil, dst.value.Unix(), 10), nil
+	case time.Millisecond:
+		return strconv.AppendInt(nil, dst.value.UnixMilli(), 10), nil
+	case time.Microsecond:
+		return strconv.AppendInt(nil, dst.value.UnixMicro(), 10), nil
+	case time.Nanosecond:
+		return strconv.AppendInt(nil, dst.value.UnixNano(), 10), nil
+	}
+
+	t := dst.value
+	if dst.local {
+		t = t.In(time.Local)
+	}
+
+	layout := dst.outputLayout()
+	if layout == "" {
+		return t.MarshalText()
+	}
+
+	if y := t.Year(); y < 0 || y > 9999 {
+		return nil, fmt.Errorf("time year %d outside of range [0,9999]", y)
+	}
+	return t.AppendFormat(nil, layout), nil
+}
+
+// outputLayout returns the layout selected by the output options, or an empty string for the default.
+func (dst *Time) outputLayout() string {
+	switch {
+	case dst.dateOnly:
+		return time.DateOnly
+	case dst.format != "":
+		return dst.format
+	case dst.millis:
+		return millisLayout
+	case dst.nanos:
+		return nanosLayout
+	}
+	return ""
+}
+
+// SetNullLiteral sets the JSON literal MarshalJSON emits when the time would marshal as null,
+// e.g. []byte(`""`) for consumers that expect an empty string for missing timestamps.
+// The literal must be valid JSON, otherwise json.Marshal reports an error.
+// The slice is copied. A nil or empty literal restores the default null.
+//
+// Parameters:
+//   - literal: The JSON literal to emit instead of null.
+func (dst *Time) SetNullLiteral(literal []byte) {
+	dst.null = string(literal)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, e.g. for gob and binary caches.
+// An absent time encodes as the single byte 0x00 and a present time that was
+// not valid in lenient mode as 0x02. A present time encodes as 0x01 followed by
+// the output of time.Time.MarshalBinary, which keeps the zone offset.
+// Configuration set with the SetXxx methods is not encoded.
+//
+// Returns:
+//   - []byte: The binary representation of the time.
+//   - error: An error if the time cannot be encoded, otherwise nil.
+func (dst *Time) MarshalBinary() ([]byte, error) {
+	switch {
+	case !dst.present:
+		return []byte{0x00}, nil
+	case !dst.valid:
+		return []byte{0x02}, nil
+	}
+
+	data, err := dst.value.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{0x01}, data...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It decodes the representation produced by MarshalBinary.
+//
+// Parameters:
+//   - data: The binary data to decode.
+//
+// Returns:
+//   - error: An error if the data is malformed, otherwise nil.
+func (dst *Time) UnmarshalBinary(data []byte) error {
+	dst.value = time.Time{}
+	dst.present = false
+	dst.valid = false
+
+	switch {
+	case len(data) == 1 && data[0] == 0x00:
+		return nil
+	case len(data) == 1 && data[0] == 0x02:
+		dst.present = true
+		return nil
+	case len(data) > 1 && data[0] == 0x01:
+		var t time.Time
+		if err := t.UnmarshalBinary(data[1:]); err != nil {
+			return err
+		}
+		dst.Set(t)
+		return nil
+	}
+
+	return fmt.Errorf("invalid binary time encoding")
+}
+
+// SetEpochOutput enables or disables Unix timestamp output.
+// When unit is time.Second, time.Millisecond, time.Microsecond or time.Nanosecond,
+// MarshalJSON emits the time as an unquoted integer in that unit, e.g. 1696517280,
+// and MarshalText emits the same digits. It overrides all other output options,
+// see MarshalJSON for the precedence. A unit of zero disables it (the default).
+// While enabled, UnmarshalJSON also accepts bare integers as timestamps in that
+// unit, in UTC, so the output decodes back into the same Time.
+// It panics for any other unit.
+//
+// Parameters:
+//   - unit: The timestamp unit, or zero to disable epoch output.
+func (dst *Time) SetEpochOutput(unit time.Duration) {
+	switch unit {
+	case 0, time.Second, time.Millisecond, time.Microsecond, time.Nanosecond:
+		dst.epoch = unit
+	default:
+		panic(fmt.Sprintf("params: Time.SetEpochOutput called with unsupported unit %s", unit))
+	}
+}
+
+// SetOutputFormat selects a named output layout for systems that expect an older
+// date format: "RFC822", "RFC850" or "RFC1123", mapping to the time constants of
+// the same name, e.g. "05 Oct 23 14:48 UTC" for RFC822. It takes precedence over
+// SetMillisOutput and SetTrimFractionalZeros but not over SetEpochOutput and
+// SetDateOnlyOutput. An empty name restores the default RFC 3339 output.
+// An absent time still marshals as null. While a format is set, UnmarshalJSON
+// accepts it too, so the output decodes back into the same Time, truncated to
+// the precision of the format, e.g. to minutes for RFC822.
+// It panics for any other name.
+//
+// Parameters:
+//   - name: The name of the output format, or an empty string for the default.
+func (dst *Time) SetOutputFormat(name string) {
+	if name == "" {
+		dst.format = ""
+		return
+	}
+	layout, ok := outputFormats[name]
+	if !ok {
+		panic(fmt.Sprintf("params: Time.SetOutputFormat called with unsupported format %q", name))
+	}
+	dst.format = layout
+}
+
+// SetDateOnlyOutput enables or disables date-only output.
+// When enabled, MarshalJSON and MarshalText emit only the date, e.g.
+// "2023-10-05", dropping the time of day. The date is taken in the zone of
+// the value, or in time.Local if SetMarshalLocal is enabled. It takes
+// precedence over SetMillisOutput and SetTrimFractionalZeros and is
+// disabled by default. An absent time still marshals as null.
+// While enabled, UnmarshalJSON accepts dates like "2023-10-05" as midnight UTC,
+// so the output decodes back into the same Time, without its time of day.
+//
+// Parameters:
+//   - dateOnly: True to emit only the date.
+func (dst *Time) SetDateOnlyOutput(dateOnly bool) {
+	dst.dateOnly = dateOnly
+}
+
+// SetMarshalLocal enables or disables local time output.
+// When enabled, MarshalJSON and MarshalText convert the value to time.Local
+// before formatting. Note that this makes the output depend on the TZ
+// setting of the process, so deployments in different zones will emit
+// different strings for the same instant. It is disabled by default.
+//
+// Parameters:
+//   - local: True to render the time in the local zone.
+func (dst *Time) SetMarshalLocal(local bool) {
+	dst.local = local
+}
+
+// SetMillisOutput enables or disables fixed millisecond output.
+// When enabled, MarshalJSON and MarshalText always emit exactly three
+// fractional digits, e.g. "2023-10-05T14:48:00.000Z" or
+// "2023-10-05T14:48:00.123Z", truncating any finer precision.
+// It is disabled by default.
+//
+// Parameters:
+//   - millis: True to always emit three fractional digits.
+func (dst *Time) SetMillisOutput(millis bool) {
+	dst.millis = millis
+}
+
+// SetTrimFractionalZeros controls trailing zeros in the fractional seconds.
+// When enabled (the default, matching time.Time.MarshalJSON), trailing zeros
+// are trimmed, so ".120Z" is emitted as ".12Z" and whole seconds have no fraction.
+// When disabled, MarshalJSON and MarshalText always emit nine fractional digits,
+// e.g. "2023-10-05T14:48:00.120000000Z". SetMillisOutput takes precedence.
+//
+// Parameters:
+//   - trim: True to trim trailing fractional zeros, false to keep nine digits.
+func (dst *Time) SetTrimFractionalZeros(trim bool) {
+	dst.nanos = !trim
+}
+
+// SetZeroAsNull controls how a present zero time is marshalled.
+// When enabled, a present time equal to time.Time{} marshals as null instead
+// of "0001-01-01T00:00:00Z". It is disabled by default.
+//
+// Parameters:
+//   - zeroAsNull: True to marshal a present zero time as null.
+func (dst *Time) SetZeroAsNull(zeroAsNull bool) {
+	dst.zeroAsNull = zeroAsNull
+}
+
+// SetLenient enables or disables lenient parsing.
+// When enabled, UnmarshalJSON does not fail on a value that matches no layout;
+// the time is marked present but not valid, which can be checked with Valid.
+// This allows collecting per-field time errors in a partial-parse flow.
+// It is disabled by default.
+//
+// Parameters:
+//   - lenient: True to mark unparsable values invalid instead of returning an error.
+func (dst *Time) SetLenient(lenient bool) {
+	dst.lenient = lenient
+}
+
+// SetBounds restricts the times accepted by UnmarshalJSON to [min, max].
+// A parsed time outside the window makes UnmarshalJSON return an error naming
+// the violated bound and leaves the Time not present. A zero min or max leaves
+// that side unbounded, so SetBounds(time.Time{}, time.Time{}) removes the bounds.
+// An empty string decodes as the zero time, so it is rejected if min is set.
+//
+// Parameters:
+//   - min: The earliest accepted time, inclusive.
+//   - max: The latest accepted time, inclusive.
+func (dst *Time) SetBounds(min, max time.Time) {
+	dst.minTime = min
+	dst.maxTime = max
+}
+
+// SetLayoutCache enables or disables layout caching.
+// When enabled, UnmarshalJSON remembers the layout that last parsed successfully
+// and tries it first on subsequent calls, which saves parse attempts when
+// decoding homogeneous data into the same Time repeatedly. All other layouts
+// are still tried as a fallback, so the result is unaffected.
+// It is disabled by default.
+//
+// Parameters:
+//   - cache: True to try the last successful layout first.
+func (dst *Time) SetLayoutCache(cache bool) {
+	dst.cache = cache
+	dst.lastLayout = 0
+}
+
+// Valid checks if the Time type holds a successfully parsed value.
+// It returns false if the time is not present or failed to parse in lenient mode.
+//
+// Returns:
+//   - bool: True if the time is present and valid, otherwise false.
+func (dst *Time) Valid() bool {
+	return dst.present && dst.valid
+}
+
+// marshalNull reports whether the time marshals as null.
+// Invalid values have nothing meaningful to emit, so they marshal as null too.
+func (dst *Time) marshalNull() bool {
+	return !dst.present || !dst.valid || (dst.zeroAsNull && dst.value.IsZero())
 }
 
 // IsZero checks if the Time is zero or not present.
@@ -113,6 +542,30 @@ func (dst *Time) Format(layout string) string {
 	return dst.value.Format(layout)
 }
 
+// FormatDefault formats the Time using the layout configured with the output options,
+// such as SetDateOnlyOutput, SetOutputFormat or SetMillisOutput, or RFC 3339 with trimmed fractional
+// seconds as MarshalJSON emits by default. SetMarshalLocal is honored as well, while
+// SetEpochOutput is not, since it selects a number rather than a layout.
+// It returns an empty string whenever MarshalText does, i.e. if the Time is not
+// present, not valid, or zero with SetZeroAsNull enabled.
+//
+// Returns:
+//   - string: Formatted time string or empty string if the Time marshals as null.
+func (dst *Time) FormatDefault() string {
+	if dst.marshalNull() {
+		return ""
+	}
+	t := dst.value
+	if dst.local {
+		t = t.In(time.Local)
+	}
+	layout := dst.outputLayout()
+	if layout == "" {
+		layout = time.RFC3339Nano
+	}
+	return t.Format(layout)
+}
+
 // Equal checks if two Time instances are equal.
 //
 // Returns:
@@ -132,6 +585,99 @@ func (dst *Time) String() string {
 func (dst *Time) Set(value time.Time) {
 	dst.value = value
 	dst.present = true
+	dst.valid = true
+}
+
+// Scan implements the sql.Scanner interface.
+// It accepts time.Time sources, string and []byte sources in the layouts
+// accepted by UnmarshalJSON, and int64 or int32 sources holding Unix seconds,
+// or milliseconds if SetScanEpochMillis is enabled, for integer epoch columns.
+// Epoch values are set in UTC. A nil source sets Present to false;
+// any other supported source sets Present to true.
+//
+// Parameters:
+//   - src: The database value to scan into the Time type.
+//
+// Returns:
+//   - error: An error if the source type is not supported or cannot be parsed, otherwise nil.
+func (dst *Time) Scan(src any) error {
+	dst.value = time.Time{}
+	dst.present = false
+	dst.valid = false
+
+	var epoch int64
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case time.Time:
+		dst.Set(v)
+		return nil
+	case string:
+		return dst.scanText(v)
+	case []byte:
+		return dst.scanText(string(v))
+	case int64:
+		epoch = v
+	case int32:
+		epoch = int64(v)
+	default:
+		return fmt.Errorf("unsupported Scan source type for Time: %T", src)
+	}
+
+	if dst.scanMillis {
+		dst.SetUnixMilli(epoch)
+	} else {
+		dst.SetUnix(epoch)
+	}
+	return nil
+}
+
+// scanText parses a textual Scan source.
+func (dst *Time) scanText(str string) error {
+	t, ok := dst.parse(str, nil)
+	if !ok {
+		return fmt.Errorf("invalid time format: %s", str)
+	}
+	dst.Set(t)
+	return nil
+}
+
+// SetScanEpochMillis controls how Scan reads integer sources.
+// When enabled, int64 and int32 sources are read as Unix milliseconds instead
+// of Unix seconds. It is disabled by default.
+//
+// Parameters:
+//   - millis: True to read integer sources as milliseconds.
+func (dst *Time) SetScanEpochMillis(millis bool) {
+	dst.scanMillis = millis
+}
+
+// SetUnix sets the value from Unix seconds and marks it as present.
+// The time is set in UTC, so the marshalled output does not depend on the
+// zone of the process.
+//
+// Parameters:
+//   - sec: The number of seconds since the Unix epoch.
+func (dst *Time) SetUnix(sec int64) {
+	dst.Set(time.Unix(sec, 0).UTC())
+}
+
+// SetUnixMilli sets the value from Unix milliseconds and marks it as present.
+// The time is set in UTC.
+//
+// Parameters:
+//   - ms: The number of milliseconds since the Unix epoch.
+func (dst *Time) SetUnixMilli(ms int64) {
+	dst.Set(time.UnixMilli(ms).UTC())
+}
+
+// SetUnixNano sets the value from Unix nanoseconds and marks it as present.
+// The time is set in UTC.
+//
+// Parameters:
+//   - ns: The number of nanoseconds since the Unix epoch.
+func (dst *Time) SetUnixNano(ns int64) {
+	dst.Set(time.Unix(0, ns).UTC())
 }
 
 // Present checks if the Time type is present in the JSON payload.
@@ -155,3 +701,368 @@ func (dst *Time) Value() time.Time {
 	}
 	return dst.value
 }
+
+// MustValue is like Value but panics if the time is not present.
+// Like Int.MustValue, it is meant for tests, not for request-handling code.
+//
+// Returns:
+//   - time.Time: The value of the Time type.
+func (dst *Time) MustValue() time.Time {
+	if !dst.present {
+		panic("params: MustValue called on an absent Time")
+	}
+	return dst.value
+}
+
+// AddDate returns a copy of the Time with the given years, months and days added.
+// It mirrors time.Time.AddDate. The present flag is preserved, so an absent Time stays absent.
+//
+// Parameters:
+//   - years: The number of years to add.
+//   - months: The number of months to add.
+//   - days: The number of days to add.
+//
+// Returns:
+//   - Time: A new Time with the adjusted value.
+func (dst *Time) AddDate(years, months, days int) Time {
+	t := *dst
+	if t.present {
+		t.value = t.value.AddDate(years, months, days)
+	}
+	return t
+}
+
+// Add returns a copy of the Time with the duration d added, e.g. createdAt plus a TTL.
+// It mirrors time.Time.Add. The present flag is preserved, so an absent Time stays absent.
+//
+// Parameters:
+//   - d: The duration to add.
+//
+// Returns:
+//   - Time: A new Time with the adjusted value.
+func (dst *Time) Add(d time.Duration) Time {
+	t := *dst
+	if t.present {
+		t.value = t.value.Add(d)
+	}
+	return t
+}
+
+// Year returns the year of the time, or 0 if the time is not present.
+//
+// Returns:
+//   - int: The year if present, otherwise 0.
+func (dst *Time) Year() int {
+	if !dst.present {
+		return 0
+	}
+	return dst.value.Year()
+}
+
+// Month returns the month of the time, or 0 if the time is not present.
+// Note that 0 is not a valid time.Month, so it can be told apart from January.
+//
+// Returns:
+//   - time.Month: The month if present, otherwise 0.
+func (dst *Time) Month() time.Month {
+	if !dst.present {
+		return 0
+	}
+	return dst.value.Month()
+}
+
+// Day returns the day of the month of the time, or 0 if the time is not present.
+//
+// Returns:
+//   - int: The day of the month if present, otherwise 0.
+func (dst *Time) Day() int {
+	if !dst.present {
+		return 0
+	}
+	return dst.value.Day()
+}
+
+// Weekday returns the day of the week of the time.
+// If the time is not present, it returns 0, which equals time.Sunday,
+// so callers that need to tell them apart should check Present first.
+//
+// Returns:
+//   - time.Weekday: The day of the week if present, otherwise 0.
+func (dst *Time) Weekday() time.Weekday {
+	if !dst.present {
+		return 0
+	}
+	return dst.value.Weekday()
+}
+
+// Unix returns the time as Unix seconds, or 0 if the time is not present.
+// Since 0 is also a valid epoch (1970-01-01T00:00:00Z), callers that need
+// to tell them apart should check Present first.
+//
+// Returns:
+//   - int64: The number of seconds since the Unix epoch if present, otherwise 0.
+func (dst *Time) Unix() int64 {
+	if !dst.present {
+		return 0
+	}
+	return dst.value.Unix()
+}
+
+// UnixMilli returns the time as Unix milliseconds, or 0 if the time is not present.
+// See Unix for the meaning of the zero result.
+//
+// Returns:
+//   - int64: The number of milliseconds since the Unix epoch if present, otherwise 0.
+func (dst *Time) UnixMilli() int64 {
+	if !dst.present {
+		return 0
+	}
+	return dst.value.UnixMilli()
+}
+
+// UnixNano returns the time as Unix nanoseconds, or 0 if the time is not present.
+// See Unix for the meaning of the zero result.
+//
+// Returns:
+//   - int64: The number of nanoseconds since the Unix epoch if present, otherwise 0.
+func (dst *Time) UnixNano() int64 {
+	if !dst.present {
+		return 0
+	}
+	return dst.value.UnixNano()
+}
+
+// Since returns the time elapsed since the value, as time.Since does.
+// If the time is not present, it returns 0, so an absent Time reads as no elapsed time.
+//
+// Returns:
+//   - time.Duration: The elapsed time if present, otherwise 0.
+func (dst *Time) Since() time.Duration {
+	if !dst.present {
+		return 0
+	}
+	return now().Sub(dst.value)
+}
+
+// Until returns the duration until the value, as time.Until does.
+// If the time is not present, it returns 0.
+//
+// Returns:
+//   - time.Duration: The remaining time if present, otherwise 0.
+func (dst *Time) Until() time.Duration {
+	if !dst.present {
+		return 0
+	}
+	return dst.value.Sub(now())
+}
+
+// IsPast reports whether the time is present and before the current time.
+//
+// Returns:
+//   - bool: True if the time is present and in the past, otherwise false.
+func (dst *Time) IsPast() bool {
+	return dst.present && dst.value.Before(now())
+}
+
+// IsFuture reports whether the time is present and after the current time.
+//
+// Returns:
+//   - bool: True if the time is present and in the future, otherwise false.
+func (dst *Time) IsFuture() bool {
+	return dst.present && dst.value.After(now())
+}
+
+// IsToday reports whether the time is present and falls on the current date
+// in the local time zone of the server. Use IsTodayIn for another zone.
+//
+// Returns:
+//   - bool: True if the time is present and today, otherwise false.
+func (dst *Time) IsToday() bool {
+	return dst.IsTodayIn(time.Local)
+}
+
+// IsTodayIn reports whether the time is present and falls on the current date in loc.
+// Both the time and the current time are converted to loc before their dates are compared.
+//
+// Parameters:
+//   - loc: The location defining the calendar day.
+//
+// Returns:
+//   - bool: True if the time is present and today in loc, otherwise false.
+func (dst *Time) IsTodayIn(loc *time.Location) bool {
+	if !dst.present {
+		return false
+	}
+	y1, m1, d1 := dst.value.In(loc).Date()
+	y2, m2, d2 := now().In(loc).Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+// Compare compares the time with other and returns -1, 0 or +1.
+// An absent time is less than any present time and two absent times are equal.
+// Present times are compared by instant as in time.Time.Compare, so the result
+// can be used with slices.SortFunc.
+//
+// Parameters:
+//   - other: The Time to compare with.
+//
+// Returns:
+//   - int: -1 if the time is before other, +1 if after, 0 if equal.
+func (dst *Time) Compare(other Time) int {
+	switch {
+	case !dst.present && !other.present:
+		return 0
+	case !dst.present:
+		return -1
+	case !other.present:
+		return 1
+	}
+	return dst.value.Compare(other.value)
+}
+
+// EqualWithin reports whether the time and other are the same instant up to tol,
+// e.g. when comparing a microsecond database timestamp with a parsed nanosecond one.
+// Two present times are equal if their difference is at most tol in either
+// direction. Two absent times are equal, while an absent and a present time are not.
+//
+// Parameters:
+//   - other: The Time to compare with.
+//   - tol: The maximum allowed difference.
+//
+// Returns:
+//   - bool: True if the times are equal within tol, otherwise false.
+func (dst *Time) EqualWithin(other Time, tol time.Duration) bool {
+	if !dst.present || !other.present {
+		return dst.present == other.present
+	}
+	d := dst.value.Sub(other.value)
+	return d <= tol && d >= -tol
+}
+
+// Ptr returns a pointer to a copy of the time value, or nil if it is not present.
+// It converts the Time type to the pointer-based optional form used by many generated clients.
+//
+// Returns:
+//   - *time.Time: A pointer to the value if present, otherwise nil.
+func (dst *Time) Ptr() *time.Time {
+	if !dst.present {
+		return nil
+	}
+	v := dst.value
+	return &v
+}
+
+// TimeFromPtr creates a Time from a pointer-based optional value.
+// A nil pointer yields an absent Time, otherwise the pointed-to value is copied and marked present.
+// TimeFromPtr(v.Ptr()) keeps the value and presence of v, but not its output
+// options such as SetMillisOutput, and a present but invalid v becomes a valid zero time.
+//
+// Parameters:
+//   - p: The pointer to convert.
+//
+// Returns:
+//   - Time: The converted Time.
+func TimeFromPtr(p *time.Time) Time {
+	var v Time
+	if p != nil {
+		v.Set(*p)
+	}
+	return v
+}
+
+// ParseTime parses s with the layouts accepted by Time.UnmarshalJSON and the
+// parsers added with RegisterTimeParser, for input outside of JSON such as
+// command-line arguments or configuration files. It resolves zones like time.Parse:
+// layouts without a zone are read as UTC, and zone abbreviations known to time.Local,
+// such as "EEST" in Europe/Helsinki, get their offset there.
+//
+// Parameters:
+//   - s: The unquoted time string to parse.
+//
+// Returns:
+//   - Time: A present Time if parsing succeeds.
+//   - error: An error if s matches none of the layouts, otherwise nil.
+func ParseTime(s string) (Time, error) {
+	return parseTime(s, nil)
+}
+
+// ParseTimeInLocation is like ParseTime but reads layouts without a zone, such as
+// "2006-01-02 15:04:05", in loc, as time.ParseInLocation does. Zone abbreviations
+// are resolved against loc instead of time.Local. Registered parsers
+// decide the zone of their results themselves.
+//
+// Parameters:
+//   - s: The unquoted time string to parse.
+//   - loc: The location for times without a zone.
+//
+// Returns:
+//   - Time: A present Time if parsing succeeds.
+//   - error: An error if s matches none of the layouts, otherwise nil.
+func ParseTimeInLocation(s string, loc *time.Location) (Time, error) {
+	return parseTime(s, loc)
+}
+
+// parseTime implements ParseTime and ParseTimeInLocation, see Time.parse for a nil loc.
+func parseTime(s string, loc *time.Location) (Time, error) {
+	var dst Time
+	t, ok := dst.parse(s, loc)
+	if !ok {
+		return Time{}, fmt.Errorf("invalid time format: %s", s)
+	}
+	dst.Set(t)
+	return dst, nil
+}
+
+// fastParser carries the layout cache of ParseTimeFast between calls.
+type fastParser struct {
+	lastLayout int // LastLayout is the index of the last successful layout plus one, zero if none
+}
+
+// fastParsers pools the parsers used by ParseTimeFast, so each goroutine
+// typically reuses a parser whose cache matches its own input.
+var fastParsers = sync.Pool{New: func() any { return new(fastParser) }}
+
+// ParseTimeFast decodes a JSON time value like Time.UnmarshalJSON in lenient mode,
+// for ingestion paths decoding large numbers of timestamps in the same layout.
+// It trims the quotes on the byte slice before converting it, and a pooled layout
+// cache lets repeated calls skip the layouts that failed before, which would
+// otherwise allocate a parse error each. b is not retained.
+//
+// Null or empty input yields an absent Time. Input that matches no layout yields
+// a present Time that is not valid, which callers detect with Valid.
+//
+// Parameters:
+//   - b: The JSON data to decode, quoted or not.
+//
+// Returns:
+//   - Time: The decoded Time.
+func ParseTimeFast(b []byte) Time {
+	var dst Time
+	if len(b) == 0 || string(b) == "null" {
+		return dst
+	}
+	dst.present = true
+	if string(b) == `""` {
+		dst.valid = true
+		return dst
+	}
+
+	for len(b) > 0 && b[0] == '"' {
+		b = b[1:]
+	}
+	for len(b) > 0 && b[len(b)-1] == '"' {
+		b = b[:len(b)-1]
+	}
+
+	p := fastParsers.Get().(*fastParser)
+	parser := Time{cache: true, lastLayout: p.lastLayout}
+	t, ok := parser.parse(string(b), nil)
+	p.lastLayout = parser.lastLayout
+	fastParsers.Put(p)
+
+	if ok {
+		dst.value = t
+		dst.valid = true
+	}
+	return dst
+}
@@ -1,7 +1,13 @@
 package params
 
 import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
 	"encoding/json"
+	"log/slog"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -79,3 +85,962 @@ func TestTime_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestTime_MarshalText(t *testing.T) {
+	var absent Time
+	got, err := absent.MarshalText()
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Empty(t, got, "absent Time should marshal to empty text")
+
+	var dst Time
+	dst.Set(time.Date(2023, 10, 5, 14, 48, 0, 123000000, time.UTC))
+	got, err = dst.MarshalText()
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, "2023-10-05T14:48:00.123Z", string(got), "MarshalText() mismatch")
+
+	js, err := dst.MarshalJSON()
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, `"`+string(got)+`"`, string(js), "MarshalText() should match MarshalJSON() without quotes")
+
+	var back Time
+	require.NoError(t, back.UnmarshalText(got), "UnmarshalText() should accept MarshalText() output")
+	require.True(t, back.Value().Equal(dst.Value()), "round trip mismatch")
+}
+
+func TestTime_SetZeroAsNull(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      time.Time
+		zeroAsNull bool
+		want       string
+	}{
+		{name: "zero time by default", value: time.Time{}, want: `"0001-01-01T00:00:00Z"`},
+		{name: "zero time as null", value: time.Time{}, zeroAsNull: true, want: `null`},
+		{name: "non-zero time with option", value: time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC), zeroAsNull: true, want: `"2023-10-05T14:48:00Z"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst Time
+			dst.SetZeroAsNull(tt.zeroAsNull)
+			dst.Set(tt.value)
+			got, gotErr := json.Marshal(&dst)
+			require.NoError(t, gotErr, "unexpected error: %v", gotErr)
+			require.Equal(t, tt.want, string(got), "MarshalJSON() mismatch")
+			require.True(t, dst.Present(), "Present field mismatch")
+		})
+	}
+}
+
+func TestTime_AddDate(t *testing.T) {
+	var absent Time
+	got := absent.AddDate(0, 1, 0)
+	require.False(t, got.Present(), "absent Time should stay absent")
+	require.True(t, got.Value().IsZero(), "absent Time should keep zero value")
+
+	var dst Time
+	dst.Set(time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC))
+	got = dst.AddDate(1, 1, 1)
+	require.True(t, got.Present(), "present Time should stay present")
+	require.True(t, got.Value().Equal(time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)), "AddDate() mismatch: got %v", got.Value())
+	require.True(t, dst.Value().Equal(time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)), "original Time should not change")
+}
+
+func TestTime_Add(t *testing.T) {
+	var absent Time
+	got := absent.Add(time.Hour)
+	require.False(t, got.Present(), "absent Time should stay absent")
+	require.True(t, got.Value().IsZero(), "absent Time should keep zero value")
+
+	var dst Time
+	dst.Set(time.Date(2024, 1, 31, 23, 30, 0, 0, time.UTC))
+	got = dst.Add(90 * time.Minute)
+	require.True(t, got.Present(), "present Time should stay present")
+	require.True(t, got.Value().Equal(time.Date(2024, 2, 1, 1, 0, 0, 0, time.UTC)), "Add() mismatch: got %v", got.Value())
+	require.True(t, dst.Value().Equal(time.Date(2024, 1, 31, 23, 30, 0, 0, time.UTC)), "original Time should not change")
+}
+
+func TestTime_DateComponents(t *testing.T) {
+	var absent Time
+	require.Equal(t, 0, absent.Year(), "absent Year should be 0")
+	require.Equal(t, time.Month(0), absent.Month(), "absent Month should be 0")
+	require.Equal(t, 0, absent.Day(), "absent Day should be 0")
+	require.Equal(t, time.Weekday(0), absent.Weekday(), "absent Weekday should be 0")
+
+	var dst Time
+	dst.Set(time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC))
+	require.Equal(t, 2023, dst.Year(), "Year mismatch")
+	require.Equal(t, time.October, dst.Month(), "Month mismatch")
+	require.Equal(t, 5, dst.Day(), "Day mismatch")
+	require.Equal(t, time.Thursday, dst.Weekday(), "Weekday mismatch")
+}
+
+func TestTime_Unix(t *testing.T) {
+	var absent Time
+	require.Equal(t, int64(0), absent.Unix(), "absent Unix should be 0")
+	require.Equal(t, int64(0), absent.UnixMilli(), "absent UnixMilli should be 0")
+	require.Equal(t, int64(0), absent.UnixNano(), "absent UnixNano should be 0")
+
+	var dst Time
+	dst.Set(time.Date(2023, 10, 5, 14, 48, 0, 123456789, time.UTC))
+	require.Equal(t, int64(1696517280), dst.Unix(), "Unix mismatch")
+	require.Equal(t, int64(1696517280123), dst.UnixMilli(), "UnixMilli mismatch")
+	require.Equal(t, int64(1696517280123456789), dst.UnixNano(), "UnixNano mismatch")
+
+	var epoch Time
+	epoch.Set(time.Unix(0, 0))
+	require.Equal(t, int64(0), epoch.Unix(), "epoch Unix should be 0")
+	require.True(t, epoch.Present(), "epoch should be present")
+}
+
+func TestTime_SetUnix(t *testing.T) {
+	want := time.Date(2023, 10, 5, 14, 48, 0, 123456789, time.UTC)
+
+	var dst Time
+	dst.SetUnix(1696517280)
+	require.True(t, dst.Present(), "SetUnix should mark the time present")
+	require.Equal(t, want.Truncate(time.Second), dst.Value(), "SetUnix mismatch")
+
+	dst = Time{}
+	dst.SetUnixMilli(1696517280123)
+	require.True(t, dst.Present(), "SetUnixMilli should mark the time present")
+	require.Equal(t, want.Truncate(time.Millisecond), dst.Value(), "SetUnixMilli mismatch")
+
+	dst = Time{}
+	dst.SetUnixNano(1696517280123456789)
+	require.True(t, dst.Present(), "SetUnixNano should mark the time present")
+	require.Equal(t, want, dst.Value(), "SetUnixNano mismatch")
+
+	got, err := json.Marshal(&dst)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, `"2023-10-05T14:48:00.123456789Z"`, string(got), "MarshalJSON() should render UTC")
+}
+
+func TestTime_SetLenient(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		lenient bool
+		present bool
+		valid   bool
+		wantErr bool
+	}{
+		{name: "invalid strict", data: `"not-a-time"`, present: true, valid: false, wantErr: true},
+		{name: "invalid lenient", data: `"not-a-time"`, lenient: true, present: true, valid: false},
+		{name: "valid lenient", data: `"2023-10-05T14:48:00Z"`, lenient: true, present: true, valid: true},
+		{name: "null lenient", data: `null`, lenient: true, present: false, valid: false},
+		{name: "empty string lenient", data: `""`, lenient: true, present: true, valid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst Time
+			dst.SetLenient(tt.lenient)
+			gotErr := dst.UnmarshalJSON([]byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, gotErr, "expected an error but got none")
+			} else {
+				require.NoError(t, gotErr, "unexpected error: %v", gotErr)
+			}
+			require.Equal(t, tt.present, dst.Present(), "Present field mismatch")
+			require.Equal(t, tt.valid, dst.Valid(), "Valid field mismatch")
+		})
+	}
+
+	var dst Time
+	dst.SetLenient(true)
+	require.NoError(t, dst.UnmarshalJSON([]byte(`"garbage"`)), "unexpected error")
+	got, err := json.Marshal(&dst)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, "null", string(got), "invalid Time should marshal as null")
+
+	dst.Set(time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC))
+	require.True(t, dst.Valid(), "Set should mark the Time valid")
+}
+
+func TestTime_Compare(t *testing.T) {
+	var absent, early, late, sameInstant Time
+	early.Set(time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC))
+	late.Set(time.Date(2023, 10, 6, 14, 48, 0, 0, time.UTC))
+	sameInstant.Set(time.Date(2023, 10, 5, 16, 48, 0, 0, time.FixedZone("UTC+2", 2*60*60)))
+
+	require.Equal(t, 0, absent.Compare(Time{}), "two absent times should be equal")
+	require.Equal(t, -1, absent.Compare(early), "absent should be less than present")
+	require.Equal(t, 1, early.Compare(absent), "present should be greater than absent")
+	require.Equal(t, -1, early.Compare(late), "early should be less than late")
+	require.Equal(t, 1, late.Compare(early), "late should be greater than early")
+	require.Equal(t, 0, early.Compare(sameInstant), "same instant in different zones should be equal")
+
+	times := []Time{late, absent, early}
+	slices.SortFunc(times, func(a, b Time) int { return a.Compare(b) })
+	require.False(t, times[0].Present(), "absent should sort first")
+	require.True(t, times[1].Value().Equal(early.Value()), "early should sort second")
+	require.True(t, times[2].Value().Equal(late.Value()), "late should sort last")
+}
+
+func TestTime_SetLayoutCache(t *testing.T) {
+	var dst Time
+	dst.SetLayoutCache(true)
+
+	require.NoError(t, dst.UnmarshalJSON([]byte(`"2023-10-05 14:48:00"`)), "unexpected error")
+	require.Equal(t, 3, dst.lastLayout, "space layout should be remembered")
+	require.True(t, dst.Value().Equal(time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC)), "Value field mismatch")
+
+	require.NoError(t, dst.UnmarshalJSON([]byte(`"2023-10-06 10:00:00"`)), "unexpected error")
+	require.True(t, dst.Value().Equal(time.Date(2023, 10, 6, 10, 0, 0, 0, time.UTC)), "cached layout should parse")
+
+	require.NoError(t, dst.UnmarshalJSON([]byte(`"2023-10-07T08:00:00Z"`)), "fallback layouts should still be tried")
+	require.Equal(t, 1, dst.lastLayout, "RFC3339 layout should be remembered")
+	require.True(t, dst.Value().Equal(time.Date(2023, 10, 7, 8, 0, 0, 0, time.UTC)), "Value field mismatch")
+
+	require.Error(t, dst.UnmarshalJSON([]byte(`"invalid"`)), "invalid time should still fail")
+	require.Equal(t, 1, dst.lastLayout, "failed parse should keep the cached layout")
+
+	var plain Time
+	require.NoError(t, plain.UnmarshalJSON([]byte(`"2023-10-05 14:48:00"`)), "unexpected error")
+	require.Equal(t, 0, plain.lastLayout, "layout should not be cached by default")
+}
+
+func TestTime_Ptr(t *testing.T) {
+	var absent Time
+	require.Nil(t, absent.Ptr(), "absent Time should return nil")
+	fromNil := TimeFromPtr(nil)
+	require.False(t, fromNil.Present(), "nil pointer should yield an absent Time")
+
+	value := time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC)
+	var dst Time
+	dst.Set(value)
+	p := dst.Ptr()
+	require.NotNil(t, p, "present Time should return a pointer")
+	require.True(t, p.Equal(value), "Ptr value mismatch")
+
+	back := TimeFromPtr(p)
+	require.True(t, back.Present(), "TimeFromPtr should mark the value present")
+	require.True(t, back.Valid(), "TimeFromPtr should mark the value valid")
+	require.True(t, back.Value().Equal(value), "round trip mismatch")
+}
+
+func TestTime_SetBounds(t *testing.T) {
+	minTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxTime := time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		data    string
+		min     time.Time
+		max     time.Time
+		present bool
+		errText string
+	}{
+		{name: "inside window", data: `"2024-06-01T12:00:00Z"`, min: minTime, max: maxTime, present: true},
+		{name: "on minimum", data: `"2024-01-01T00:00:00Z"`, min: minTime, max: maxTime, present: true},
+		{name: "on maximum", data: `"2024-12-31T23:59:59Z"`, min: minTime, max: maxTime, present: true},
+		{name: "before minimum", data: `"2023-12-31T23:59:59Z"`, min: minTime, max: maxTime, errText: "before the minimum"},
+		{name: "after maximum", data: `"2025-01-01T00:00:00Z"`, min: minTime, max: maxTime, errText: "after the maximum"},
+		{name: "only minimum", data: `"2999-01-01T00:00:00Z"`, min: minTime, present: true},
+		{name: "only maximum", data: `"1999-01-01T00:00:00Z"`, max: maxTime, present: true},
+		{name: "null with bounds", data: `null`, min: minTime, max: maxTime, present: false},
+		{name: "empty string with bounds", data: `""`, min: minTime, max: maxTime, errText: "before the minimum"},
+		{name: "empty string with only maximum", data: `""`, max: maxTime, present: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst Time
+			dst.SetBounds(tt.min, tt.max)
+			gotErr := dst.UnmarshalJSON([]byte(tt.data))
+			if tt.errText != "" {
+				require.ErrorContains(t, gotErr, tt.errText, "bound violation mismatch")
+			} else {
+				require.NoError(t, gotErr, "unexpected error: %v", gotErr)
+			}
+			require.Equal(t, tt.present, dst.Present(), "Present field mismatch")
+		})
+	}
+}
+
+func TestTime_SetMillisOutput(t *testing.T) {
+	tests := []struct {
+		name  string
+		value time.Time
+		want  string
+	}{
+		{name: "whole seconds", value: time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC), want: `"2023-10-05T14:48:00.000Z"`},
+		{name: "milliseconds", value: time.Date(2023, 10, 5, 14, 48, 0, 120000000, time.UTC), want: `"2023-10-05T14:48:00.120Z"`},
+		{name: "microseconds truncated", value: time.Date(2023, 10, 5, 14, 48, 0, 123456000, time.UTC), want: `"2023-10-05T14:48:00.123Z"`},
+		{name: "with timezone offset", value: time.Date(2023, 10, 5, 14, 48, 0, 0, time.FixedZone("UTC+2", 2*60*60)), want: `"2023-10-05T14:48:00.000+02:00"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst Time
+			dst.SetMillisOutput(true)
+			dst.Set(tt.value)
+			got, gotErr := json.Marshal(&dst)
+			require.NoError(t, gotErr, "unexpected error: %v", gotErr)
+			require.Equal(t, tt.want, string(got), "MarshalJSON() mismatch")
+		})
+	}
+
+	var absent Time
+	absent.SetMillisOutput(true)
+	got, err := json.Marshal(&absent)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, "null", string(got), "absent Time should marshal as null")
+}
+
+func TestTime_SetMarshalLocal(t *testing.T) {
+	local := time.Local
+	t.Cleanup(func() { time.Local = local })
+	time.Local = time.FixedZone("UTC+3", 3*60*60)
+
+	var dst Time
+	dst.Set(time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC))
+
+	got, err := json.Marshal(&dst)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, `"2023-10-05T14:48:00Z"`, string(got), "MarshalJSON() should keep the zone by default")
+
+	dst.SetMarshalLocal(true)
+	got, err = json.Marshal(&dst)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, `"2023-10-05T17:48:00+03:00"`, string(got), "MarshalJSON() should render local time")
+	require.Equal(t, time.UTC, dst.Value().Location(), "Value() should not be converted")
+
+	var absent Time
+	absent.SetMarshalLocal(true)
+	got, err = json.Marshal(&absent)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, "null", string(got), "absent Time should marshal as null")
+}
+
+func TestTime_SinceUntil(t *testing.T) {
+	t.Cleanup(func() { now = time.Now })
+	current := time.Date(2023, 10, 5, 22, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+
+	var dst Time
+	require.Zero(t, dst.Since(), "Since should be zero when absent")
+	require.Zero(t, dst.Until(), "Until should be zero when absent")
+
+	dst.Set(current.Add(-time.Hour))
+	require.Equal(t, time.Hour, dst.Since(), "Since mismatch")
+	require.Equal(t, -time.Hour, dst.Until(), "Until should be negative for a past time")
+
+	dst.Set(current.Add(90 * time.Minute))
+	require.Equal(t, -90*time.Minute, dst.Since(), "Since should be negative for a future time")
+	require.Equal(t, 90*time.Minute, dst.Until(), "Until mismatch")
+}
+
+func TestTime_Comparable(t *testing.T) {
+	var a, b Time
+	a.SetNullLiteral([]byte(`""`))
+	b.SetNullLiteral([]byte(`""`))
+	require.True(t, a == b, "Time should stay comparable with ==")
+}
+
+func TestTime_SetNullLiteral(t *testing.T) {
+	type payload struct {
+		CreatedAt Time `json:"created_at"`
+	}
+
+	var p payload
+	got, err := json.Marshal(&p)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, `{"created_at":null}`, string(got), "default literal should be null")
+
+	literal := []byte(`""`)
+	p.CreatedAt.SetNullLiteral(literal)
+	literal[0] = 'x'
+	got, err = json.Marshal(&p)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, `{"created_at":""}`, string(got), "configured literal should be emitted")
+
+	p.CreatedAt.Set(time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC))
+	got, err = json.Marshal(&p)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, `{"created_at":"2023-10-05T14:48:00Z"}`, string(got), "present time should be unaffected")
+
+	p.CreatedAt.SetNullLiteral(nil)
+	require.NoError(t, p.CreatedAt.UnmarshalJSON([]byte("null")), "unexpected error")
+	got, err = json.Marshal(&p)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, `{"created_at":null}`, string(got), "nil literal should restore null")
+}
+
+func TestTime_MustValue(t *testing.T) {
+	var dst Time
+	require.Panics(t, func() { dst.MustValue() }, "MustValue should panic when absent")
+
+	want := time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC)
+	dst.Set(want)
+	require.Equal(t, want, dst.MustValue(), "MustValue mismatch")
+}
+
+func TestTime_Predicates(t *testing.T) {
+	t.Cleanup(func() { now = time.Now })
+	current := time.Date(2023, 10, 5, 22, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+
+	tokyo := time.FixedZone("UTC+9", 9*60*60)
+	tests := []struct {
+		name    string
+		value   time.Time
+		present bool
+		past    bool
+		future  bool
+		today   bool
+		tokyo   bool
+	}{
+		{name: "absent"},
+		{name: "earlier today", value: current.Add(-time.Hour), present: true, past: true, today: true, tokyo: true},
+		{name: "later today", value: current.Add(time.Hour), present: true, future: true, today: true, tokyo: true},
+		{name: "yesterday", value: current.AddDate(0, 0, -1), present: true, past: true},
+		{name: "now", value: current, present: true, today: true, tokyo: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst Time
+			if tt.present {
+				dst.Set(tt.value)
+			}
+			require.Equal(t, tt.past, dst.IsPast(), "IsPast mismatch")
+			require.Equal(t, tt.future, dst.IsFuture(), "IsFuture mismatch")
+			require.Equal(t, tt.today, dst.IsTodayIn(time.UTC), "IsTodayIn(UTC) mismatch")
+			require.Equal(t, tt.tokyo, dst.IsTodayIn(tokyo), "IsTodayIn(UTC+9) mismatch")
+		})
+	}
+
+	local := time.Local
+	t.Cleanup(func() { time.Local = local })
+	time.Local = tokyo
+	var dst Time
+	dst.Set(time.Date(2023, 10, 5, 12, 0, 0, 0, time.UTC))
+	require.False(t, dst.IsToday(), "IsToday should use the local zone")
+	require.True(t, dst.IsTodayIn(time.UTC), "IsTodayIn(UTC) mismatch")
+}
+
+func TestTime_SetTrimFractionalZeros(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  time.Time
+		trim   bool
+		millis bool
+		want   string
+	}{
+		{name: "trimmed", value: time.Date(2023, 10, 5, 14, 48, 0, 120000000, time.UTC), trim: true, want: `"2023-10-05T14:48:00.12Z"`},
+		{name: "kept", value: time.Date(2023, 10, 5, 14, 48, 0, 120000000, time.UTC), want: `"2023-10-05T14:48:00.120000000Z"`},
+		{name: "whole second kept", value: time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC), want: `"2023-10-05T14:48:00.000000000Z"`},
+		{name: "offset kept", value: time.Date(2023, 10, 5, 14, 48, 0, 5, time.FixedZone("", 3600)), want: `"2023-10-05T14:48:00.000000005+01:00"`},
+		{name: "millis takes precedence", value: time.Date(2023, 10, 5, 14, 48, 0, 120000000, time.UTC), millis: true, want: `"2023-10-05T14:48:00.120Z"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst Time
+			dst.SetTrimFractionalZeros(tt.trim)
+			dst.SetMillisOutput(tt.millis)
+			dst.Set(tt.value)
+			got, err := json.Marshal(&dst)
+			require.NoError(t, err, "unexpected error: %v", err)
+			require.Equal(t, tt.want, string(got), "MarshalJSON() mismatch")
+		})
+	}
+
+	var dst Time
+	dst.Set(time.Date(2023, 10, 5, 14, 48, 0, 120000000, time.UTC))
+	got, err := json.Marshal(&dst)
+	require.NoError(t, err, "unexpected error: %v", err)
+	want, _ := json.Marshal(dst.Value())
+	require.Equal(t, string(want), string(got), "default should match time.Time")
+}
+
+func TestTime_SetDateOnlyOutput(t *testing.T) {
+	var dst Time
+	dst.SetDateOnlyOutput(true)
+	got, err := json.Marshal(&dst)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, "null", string(got), "absent Time should marshal as null")
+
+	dst.Set(time.Date(2023, 10, 5, 23, 48, 0, 120000000, time.FixedZone("UTC-2", -2*60*60)))
+	got, err = json.Marshal(&dst)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, `"2023-10-05"`, string(got), "MarshalJSON() should emit the date only")
+
+	text, err := dst.MarshalText()
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, "2023-10-05", string(text), "MarshalText() should emit the date only")
+
+	dst.SetMillisOutput(true)
+	got, err = json.Marshal(&dst)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, `"2023-10-05"`, string(got), "date-only should take precedence over millis")
+
+	var back Time
+	require.Error(t, back.UnmarshalJSON(got), "dates should be rejected without date-only output")
+	back.SetDateOnlyOutput(true)
+	require.NoError(t, back.UnmarshalJSON(got), "date-only output should round-trip")
+	require.True(t, time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC).Equal(back.Value()), "date should decode as midnight UTC")
+	again, err := json.Marshal(&back)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, string(got), string(again), "round trip mismatch")
+}
+
+func TestTime_EqualWithin(t *testing.T) {
+	base := time.Date(2023, 10, 5, 14, 48, 0, 123456789, time.UTC)
+	present := func(v time.Time) Time {
+		var dst Time
+		dst.Set(v)
+		return dst
+	}
+
+	tests := []struct {
+		name  string
+		a     Time
+		b     Time
+		tol   time.Duration
+		equal bool
+	}{
+		{name: "both absent", equal: true},
+		{name: "absent and present", b: present(base), tol: time.Hour},
+		{name: "present and absent", a: present(base), tol: time.Hour},
+		{name: "truncated within", a: present(base), b: present(base.Truncate(time.Microsecond)), tol: time.Microsecond, equal: true},
+		{name: "reversed within", a: present(base.Truncate(time.Microsecond)), b: present(base), tol: time.Microsecond, equal: true},
+		{name: "outside", a: present(base), b: present(base.Truncate(time.Millisecond)), tol: time.Microsecond},
+		{name: "zero tolerance", a: present(base), b: present(base.In(time.FixedZone("", 3600))), equal: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.equal, tt.a.EqualWithin(tt.b, tt.tol), "EqualWithin mismatch")
+		})
+	}
+}
+
+func TestTime_MarshalBinary(t *testing.T) {
+	zone := time.FixedZone("UTC+5:30", 5*60*60+30*60)
+	present := time.Date(2023, 10, 5, 14, 48, 0, 123456789, zone)
+
+	var invalid Time
+	invalid.SetLenient(true)
+	require.NoError(t, invalid.UnmarshalJSON([]byte(`"not a time"`)), "lenient UnmarshalJSON should not return an error")
+
+	tests := []struct {
+		name string
+		src  Time
+		want []byte
+	}{
+		{name: "absent", want: []byte{0x00}},
+		{name: "invalid", src: invalid, want: []byte{0x02}},
+		{name: "present", src: TimeFromPtr(&present)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.src.MarshalBinary()
+			require.NoError(t, err, "MarshalBinary should not return an error")
+			if tt.want != nil {
+				require.Equal(t, tt.want, data, "MarshalBinary mismatch")
+			}
+
+			var back Time
+			back.Set(time.Now())
+			require.NoError(t, back.UnmarshalBinary(data), "UnmarshalBinary should not return an error")
+			require.Equal(t, tt.src.Present(), back.Present(), "Present mismatch")
+			require.Equal(t, tt.src.Valid(), back.Valid(), "Valid mismatch")
+			require.True(t, tt.src.Value().Equal(back.Value()), "Value mismatch")
+			_, wantOffset := tt.src.Value().Zone()
+			_, gotOffset := back.Value().Zone()
+			require.Equal(t, wantOffset, gotOffset, "zone offset should be preserved")
+		})
+	}
+
+	var dst Time
+	require.Error(t, dst.UnmarshalBinary(nil), "empty data should return an error")
+	require.Error(t, dst.UnmarshalBinary([]byte{0x03}), "unknown marker should return an error")
+	require.Error(t, dst.UnmarshalBinary([]byte{0x01, 0xff}), "malformed time should return an error")
+}
+
+func TestTime_Gob(t *testing.T) {
+	type record struct {
+		CreatedAt Time
+		DeletedAt Time
+	}
+
+	var src record
+	src.CreatedAt.Set(time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC))
+
+	var buf bytes.Buffer
+	require.NoError(t, gob.NewEncoder(&buf).Encode(&src), "gob Encode should not return an error")
+
+	var dst record
+	require.NoError(t, gob.NewDecoder(&buf).Decode(&dst), "gob Decode should not return an error")
+	require.True(t, dst.CreatedAt.Present(), "CreatedAt should be present")
+	require.True(t, src.CreatedAt.Value().Equal(dst.CreatedAt.Value()), "CreatedAt mismatch")
+	require.False(t, dst.DeletedAt.Present(), "DeletedAt should stay absent")
+}
+
+func TestTime_SetEpochOutput(t *testing.T) {
+	value := time.Date(2023, 10, 5, 14, 48, 0, 123456789, time.UTC)
+
+	tests := []struct {
+		name     string
+		unit     time.Duration
+		dateOnly bool
+		millis   bool
+		trim     bool
+		want     string
+	}{
+		{name: "seconds", unit: time.Second, trim: true, want: `1696517280`},
+		{name: "milliseconds", unit: time.Millisecond, trim: true, want: `1696517280123`},
+		{name: "microseconds", unit: time.Microsecond, trim: true, want: `1696517280123456`},
+		{name: "nanoseconds", unit: time.Nanosecond, trim: true, want: `1696517280123456789`},
+		{name: "epoch over date-only", unit: time.Second, dateOnly: true, millis: true, want: `1696517280`},
+		{name: "date-only over layouts", dateOnly: true, millis: true, want: `"2023-10-05"`},
+		{name: "millis over fraction", millis: true, want: `"2023-10-05T14:48:00.123Z"`},
+		{name: "fraction layout", want: `"2023-10-05T14:48:00.123456789Z"`},
+		{name: "default", trim: true, want: `"2023-10-05T14:48:00.123456789Z"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst Time
+			dst.SetEpochOutput(tt.unit)
+			dst.SetDateOnlyOutput(tt.dateOnly)
+			dst.SetMillisOutput(tt.millis)
+			dst.SetTrimFractionalZeros(tt.trim)
+			dst.Set(value)
+
+			got, err := json.Marshal(&dst)
+			require.NoError(t, err, "unexpected error: %v", err)
+			require.Equal(t, tt.want, string(got), "MarshalJSON() mismatch")
+
+			text, err := dst.MarshalText()
+			require.NoError(t, err, "unexpected error: %v", err)
+			require.Equal(t, strings.Trim(tt.want, `"`), string(text), "MarshalText() mismatch")
+
+			var back Time
+			back.SetEpochOutput(tt.unit)
+			back.SetDateOnlyOutput(tt.dateOnly)
+			back.SetMillisOutput(tt.millis)
+			back.SetTrimFractionalZeros(tt.trim)
+			require.NoError(t, back.UnmarshalJSON(got), "output should decode with the same options")
+			again, err := json.Marshal(&back)
+			require.NoError(t, err, "unexpected error: %v", err)
+			require.Equal(t, tt.want, string(again), "round trip mismatch")
+		})
+	}
+
+	var plain Time
+	require.Error(t, plain.UnmarshalJSON([]byte(`1696517280`)), "bare integers should be rejected without epoch output")
+
+	var absent Time
+	absent.SetEpochOutput(time.Second)
+	got, err := json.Marshal(&absent)
+	require.NoError(t, err, "unexpected error: %v", err)
+	require.Equal(t, "null", string(got), "absent Time should marshal as null")
+
+	require.Panics(t, func() { absent.SetEpochOutput(time.Minute) }, "SetEpochOutput should panic on unsupported units")
+}
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		str     string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "RFC3339", str: "2023-10-05T14:48:00+02:00", want: time.Date(2023, 10, 5, 12, 48, 0, 0, time.UTC)},
+		{name: "space separated", str: "2023-10-05 14:48:00", want: time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC)},
+		{name: "invalid", str: "yesterday", wantErr: true},
+		{name: "empty", str: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTime(tt.str)
+			if tt.wantErr {
+				require.Error(t, err, "ParseTime should return an error")
+				require.False(t, got.Present(), "Time should not be present")
+				return
+			}
+			require.NoError(t, err, "ParseTime should not return an error")
+			require.True(t, got.Present(), "Time should be present")
+			require.True(t, got.Valid(), "Time should be valid")
+			require.True(t, tt.want.Equal(got.Value()), "ParseTime mismatch: got %v", got.Value())
+		})
+	}
+}
+
+func TestParseTimeInLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+3", 3*60*60)
+
+	got, err := ParseTimeInLocation("2023-10-05 14:48:00", loc)
+	require.NoError(t, err, "ParseTimeInLocation should not return an error")
+	require.True(t, time.Date(2023, 10, 5, 11, 48, 0, 0, time.UTC).Equal(got.Value()), "zone-less layout should use loc")
+	require.Equal(t, loc, got.Value().Location(), "location mismatch")
+
+	got, err = ParseTimeInLocation("2023-10-05T14:48:00Z", loc)
+	require.NoError(t, err, "ParseTimeInLocation should not return an error")
+	require.True(t, time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC).Equal(got.Value()), "explicit zone should win over loc")
+
+	_, err = ParseTimeInLocation("invalid", loc)
+	require.Error(t, err, "ParseTimeInLocation should return an error")
+}
+
+func TestTime_Scan(t *testing.T) {
+	want := time.Date(2023, 10, 5, 14, 48, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		src     any
+		millis  bool
+		want    time.Time
+		present bool
+		wantErr bool
+	}{
+		{name: "nil", src: nil},
+		{name: "time", src: want, want: want, present: true},
+		{name: "string", src: "2023-10-05T14:48:00Z", want: want, present: true},
+		{name: "bytes", src: []byte("2023-10-05 14:48:00"), want: want, present: true},
+		{name: "int64 seconds", src: int64(1696517280), want: want, present: true},
+		{name: "int32 seconds", src: int32(1696517280), want: want, present: true},
+		{name: "int64 millis", src: int64(1696517280000), millis: true, want: want, present: true},
+		{name: "zero epoch", src: int64(0), want: time.Unix(0, 0).UTC(), present: true},
+		{name: "invalid string", src: "yesterday", wantErr: true},
+		{name: "unsupported", src: 1.5, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst Time
+			dst.Set(time.Now())
+			dst.SetScanEpochMillis(tt.millis)
+			err := dst.Scan(tt.src)
+			if tt.wantErr {
+				require.Error(t, err, "Scan should return an error")
+				require.False(t, dst.Present(), "Time should not be present")
+				return
+			}
+			require.NoError(t, err, "Scan should not return an error")
+			require.Equal(t, tt.present, dst.Present(), "Present mismatch")
+			require.True(t, tt.want.Equal(dst.Value()), "Scan mismatch: got %v", dst.Value())
+		})
+	}
+}
+
+func TestParseTimeFast(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		present bool
+		valid   bool
+	}{
+		{name: "RFC3339", data: `"2023-10-05T14:48:00Z"`, present: true, valid: true},
+		{name: "space separated", data: `"2023-10-05 14:48:00"`, present: true, valid: true},
+		{name: "unquoted", data: `2023-10-05T14:48:00`, present: true, valid: true},
+		{name: "empty string", data: `""`, present: true, valid: true},
+		{name: "invalid", data: `"yesterday"`, present: true},
+		{name: "null", data: `null`},
+		{name: "empty", data: ``},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var want Time
+			want.SetLenient(true)
+			require.NoError(t, want.UnmarshalJSON([]byte(tt.data)), "UnmarshalJSON should not return an error")
+
+			got := ParseTimeFast([]byte(tt.data))
+			require.Equal(t, tt.present, got.Present(), "Present mismatch")
+			require.Equal(t, tt.valid, got.Valid(), "Valid mismatch")
+			require.Equal(t, want.Present(), got.Present(), "Present should match UnmarshalJSON")
+			require.Equal(t, want.Valid(), got.Valid(), "Valid should match UnmarshalJSON")
+			require.True(t, want.Value().Equal(got.Value()), "Value should match UnmarshalJSON")
+		})
+	}
+
+	t.Run("layout change", func(t *testing.T) {
+		// The pooled cache must not prevent other layouts from being parsed
+		got := ParseTimeFast([]byte(`"2023-10-05 14:48:00"`))
+		require.True(t, got.Valid(), "first layout should be parsed")
+		got = ParseTimeFast([]byte(`"2023-10-05T14:48:00+02:00"`))
+		require.True(t, got.Valid(), "second layout should be parsed")
+		require.True(t, time.Date(2023, 10, 5, 12, 48, 0, 0, time.UTC).Equal(got.Value()), "value mismatch")
+	})
+}
+
+func BenchmarkParseTimeFast(b *testing.B) {
+	data := []byte(`"2023-10-05 14:48:00"`)
+	b.Run("fast", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = ParseTimeFast(data)
+		}
+	})
+	b.Run("json", func(b *testing.B) {
+		b.ReportAllocs()
+		var v Time
+		for b.Loop() {
+			_ = v.UnmarshalJSON(data)
+		}
+	})
+}
+
+func TestTime_MarshalTextConsistency(t *testing.T) {
+	value := time.Date(2023, 10, 5, 14, 48, 0, 120000000, time.UTC)
+	tests := []struct {
+		name  string
+		setup func(*Time)
+		want  string
+	}{
+		{name: "default", setup: func(*Time) {}, want: "2023-10-05T14:48:00.12Z"},
+		{name: "millis", setup: func(dst *Time) { dst.SetMillisOutput(true) }, want: "2023-10-05T14:48:00.120Z"},
+		{name: "fractional zeros kept", setup: func(dst *Time) { dst.SetTrimFractionalZeros(false) }, want: "2023-10-05T14:48:00.120000000Z"},
+		{name: "date only", setup: func(dst *Time) { dst.SetDateOnlyOutput(true) }, want: "2023-10-05"},
+		{name: "epoch", setup: func(dst *Time) { dst.SetEpochOutput(time.Second) }, want: "1696517280"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst Time
+			tt.setup(&dst)
+			dst.Set(value)
+
+			text, err := dst.MarshalText()
+			require.NoError(t, err, "MarshalText() should not return an error")
+			require.Equal(t, tt.want, string(text), "MarshalText() mismatch")
+
+			js, err := dst.MarshalJSON()
+			require.NoError(t, err, "MarshalJSON() should not return an error")
+			require.Equal(t, tt.want, strings.Trim(string(js), `"`), "MarshalText() should match MarshalJSON() without quotes")
+
+			// A Time value, not only a pointer, must satisfy encoding.TextMarshaler
+			var marshaler encoding.TextMarshaler = dst
+			text, err = marshaler.MarshalText()
+			require.NoError(t, err, "MarshalText() should not return an error")
+			require.Equal(t, tt.want, string(text), "value receiver mismatch")
+		})
+	}
+
+	t.Run("slog", func(t *testing.T) {
+		var dst Time
+		dst.Set(value)
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+			ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+				if a.Key == slog.TimeKey {
+					return slog.Attr{}
+				}
+				return a
+			},
+		}))
+		logger.Info("event", "at", dst)
+		require.Equal(t, "level=INFO msg=event at=2023-10-05T14:48:00.12Z\n", buf.String(), "slog output mismatch")
+	})
+}
+
+func TestTime_FormatDefault(t *testing.T) {
+	value := time.Date(2023, 10, 5, 14, 48, 0, 120000000, time.UTC)
+	tests := []struct {
+		name  string
+		setup func(*Time)
+		want  string
+	}{
+		{name: "default", setup: func(*Time) {}, want: "2023-10-05T14:48:00.12Z"},
+		{name: "millis", setup: func(dst *Time) { dst.SetMillisOutput(true) }, want: "2023-10-05T14:48:00.120Z"},
+		{name: "date only", setup: func(dst *Time) { dst.SetDateOnlyOutput(true) }, want: "2023-10-05"},
+		{name: "epoch ignored", setup: func(dst *Time) { dst.SetEpochOutput(time.Second) }, want: "2023-10-05T14:48:00.12Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst Time
+			tt.setup(&dst)
+			require.Equal(t, "", dst.FormatDefault(), "absent Time should format as an empty string")
+			dst.Set(value)
+			require.Equal(t, tt.want, dst.FormatDefault(), "FormatDefault() mismatch")
+		})
+	}
+
+	var invalid Time
+	invalid.SetLenient(true)
+	require.NoError(t, invalid.UnmarshalJSON([]byte(`"yesterday"`)), "lenient UnmarshalJSON should not return an error")
+	require.Equal(t, "", invalid.FormatDefault(), "invalid Time should format as an empty string")
+
+	var zero Time
+	zero.SetZeroAsNull(true)
+	zero.Set(time.Time{})
+	require.Equal(t, "", zero.FormatDefault(), "zero Time should format as an empty string with SetZeroAsNull")
+}
+
+func TestTime_SetOutputFormat(t *testing.T) {
+	value := time.Date(2023, 10, 5, 14, 48, 0, 120000000, time.UTC)
+	tests := []struct {
+		name   string
+		format string
+		setup  func(*Time)
+		want   string
+	}{
+		{name: "RFC822", format: "RFC822", want: `"05 Oct 23 14:48 UTC"`},
+		{name: "RFC850", format: "RFC850", want: `"Thursday, 05-Oct-23 14:48:00 UTC"`},
+		{name: "RFC1123", format: "RFC1123", want: `"Thu, 05 Oct 2023 14:48:00 UTC"`},
+		{name: "default", format: "", want: `"2023-10-05T14:48:00.12Z"`},
+		{name: "overrides millis", format: "RFC822", setup: func(dst *Time) { dst.SetMillisOutput(true) }, want: `"05 Oct 23 14:48 UTC"`},
+		{name: "date only wins", format: "RFC822", setup: func(dst *Time) { dst.SetDateOnlyOutput(true) }, want: `"2023-10-05"`},
+		{name: "epoch wins", format: "RFC822", setup: func(dst *Time) { dst.SetEpochOutput(time.Second) }, want: `1696517280`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dst Time
+			dst.SetOutputFormat(tt.format)
+			if tt.setup != nil {
+				tt.setup(&dst)
+			}
+
+			got, err := dst.MarshalJSON()
+			require.NoError(t, err, "MarshalJSON() should not return an error")
+			require.Equal(t, "null", string(got), "absent Time should marshal as null")
+
+			dst.Set(value)
+			got, err = dst.MarshalJSON()
+			require.NoError(t, err, "MarshalJSON() should not return an error")
+			require.Equal(t, tt.want, string(got), "MarshalJSON() mismatch")
+
+			back := dst // Same options
+			require.NoError(t, back.UnmarshalJSON(got), "output should decode with the same options")
+			again, err := back.MarshalJSON()
+			require.NoError(t, err, "MarshalJSON() should not return an error")
+			require.Equal(t, tt.want, string(again), "round trip mismatch")
+		})
+	}
+
+	var dst Time
+	require.Error(t, dst.UnmarshalJSON([]byte(`"05 Oct 23 14:48 UTC"`)), "RFC822 should be rejected without the format")
+	require.Panics(t, func() { dst.SetOutputFormat("RFC3339") }, "SetOutputFormat should panic on unknown names")
+}
+
+func TestTime_ZoneAbbreviationInLocal(t *testing.T) {
+	local := time.Local
+	t.Cleanup(func() { time.Local = local })
+	time.Local = time.FixedZone("EEST", 3*60*60)
+
+	const input = "2025-09-09T13:20:25 EEST"
+	const want = 1757413225 // 13:20:25 at +03:00
+
+	var dst Time
+	require.NoError(t, dst.UnmarshalJSON([]byte(`"`+input+`"`)), "UnmarshalJSON should not return an error")
+	require.Equal(t, int64(want), dst.Unix(), "UnmarshalJSON should resolve the abbreviation in time.Local")
+
+	require.NoError(t, dst.Scan(input), "Scan should not return an error")
+	require.Equal(t, int64(want), dst.Unix(), "Scan should resolve the abbreviation in time.Local")
+
+	got, err := ParseTime(input)
+	require.NoError(t, err, "ParseTime should not return an error")
+	require.Equal(t, int64(want), got.Unix(), "ParseTime should resolve the abbreviation in time.Local")
+
+	got = ParseTimeFast([]byte(`"` + input + `"`))
+	require.Equal(t, int64(want), got.Unix(), "ParseTimeFast should resolve the abbreviation in time.Local")
+
+	got, err = ParseTime("2025-09-09 13:20:25")
+	require.NoError(t, err, "ParseTime should not return an error")
+	require.Equal(t, time.UTC, got.Value().Location(), "layouts without a zone should stay UTC")
+}
@@ -0,0 +1,188 @@
+package params
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var timeOnlyLayouts = []string{
+	time.TimeOnly, // 14:30:00
+	"15:04",       // 14:30
+}
+
+// TimeOnly is a clock time of day without a date, e.g. "14:30:00".
+type TimeOnly struct {
+	value   time.Duration // Value holds the offset from midnight
+	present bool          // Present indicates if the time is present or not
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts "15:04:05" and "15:04" layouts. Fractional seconds such as
+// "14:30:00.9" are rejected, since the type has no sub-second precision.
+// If the value is null, it sets Present to false.
+//
+// Parameters:
+//   - data: JSON data to unmarshal.
+//
+// Returns:
+//   - error: An error if unmarshaling fails, otherwise nil.
+func (dst *TimeOnly) UnmarshalJSON(data []byte) error {
+	dst.value = 0
+	dst.present = false
+
+	if len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		str = string(data)
+	}
+
+	// time.Parse accepts fractional seconds the layout does not mention.
+	if strings.ContainsAny(str, ".,") {
+		return fmt.Errorf("invalid time of day format: %s", string(data))
+	}
+
+	for _, layout := range timeOnlyLayouts {
+		if t, err := time.Parse(layout, str); err == nil {
+			dst.value = time.Duration(t.Hour())*time.Hour +
+				time.Duration(t.Minute())*time.Minute +
+				time.Duration(t.Second())*time.Second
+			dst.present = true
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid time of day format: %s", string(data))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It allows the TimeOnly type to be unmarshalled from text representations.
+// This method simply calls UnmarshalJSON with the provided text data.
+//
+// Parameters:
+//   - text: The text data to unmarshal into the TimeOnly type.
+//
+// Returns:
+//   - error: An error if the unmarshalling fails, otherwise nil.
+func (dst *TimeOnly) UnmarshalText(text []byte) error {
+	return dst.UnmarshalJSON(text)
+}
+
+// UnmarshalParam implements the custom parameter unmarshalling for the TimeOnly type.
+// It allows the TimeOnly type to be unmarshalled directly from a string parameter.
+// This method simply calls UnmarshalJSON with the provided string data.
+//
+// Parameters:
+//   - param: The string parameter to unmarshal into the TimeOnly type.
+//
+// Returns:
+//   - error: An error if the unmarshalling fails, otherwise nil.
+func (dst *TimeOnly) UnmarshalParam(param string) error {
+	return dst.UnmarshalJSON([]byte(param))
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It emits the time as "15:04:05" or null if the time is not present.
+//
+// Returns:
+//   - []byte: JSON representation of the time.
+//   - error: An error if marshaling fails, otherwise nil.
+func (dst TimeOnly) MarshalJSON() ([]byte, error) {
+	if !dst.present {
+		return []byte("null"), nil
+	}
+	return fmt.Appendf(nil, `"%02d:%02d:%02d"`, dst.Hour(), dst.Minute(), dst.Second()), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the time as "15:04:05", or empty bytes if the time is not present.
+//
+// Returns:
+//   - []byte: Text representation of the time.
+//   - error: An error if marshaling fails, otherwise nil.
+func (dst TimeOnly) MarshalText() ([]byte, error) {
+	if !dst.present {
+		return []byte{}, nil
+	}
+	return fmt.Appendf(nil, "%02d:%02d:%02d", dst.Hour(), dst.Minute(), dst.Second()), nil
+}
+
+// Set sets the clock time and marks it as present.
+// Values outside a single day are rejected.
+//
+// Parameters:
+//   - hour: The hour in the range [0, 23].
+//   - minute: The minute in the range [0, 59].
+//   - second: The second in the range [0, 59].
+//
+// Returns:
+//   - error: An error if any component is out of range, otherwise nil.
+func (dst *TimeOnly) Set(hour, minute, second int) error {
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 || second < 0 || second > 59 {
+		return fmt.Errorf("invalid time of day: %02d:%02d:%02d", hour, minute, second)
+	}
+	dst.value = time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute + time.Duration(second)*time.Second
+	dst.present = true
+	return nil
+}
+
+// Present checks if the TimeOnly type is present in the JSON payload.
+// It returns true if the time was provided in the JSON payload, otherwise false.
+//
+// Returns:
+//   - bool: True if the time is present, otherwise false.
+func (dst *TimeOnly) Present() bool {
+	return dst.present
+}
+
+// Value retrieves the clock time as an offset from midnight.
+// If the time is not present, it returns zero.
+//
+// Returns:
+//   - time.Duration: The offset from midnight if present, otherwise zero.
+func (dst *TimeOnly) Value() time.Duration {
+	if !dst.present {
+		return 0
+	}
+	return dst.value
+}
+
+// MustValue is like Value but panics if the time is not present.
+// Like Int.MustValue, it is meant for tests, not for request-handling code.
+//
+// Returns:
+//   - time.Duration: The value of the TimeOnly type.
+func (dst *TimeOnly) MustValue() time.Duration {
+	if !dst.present {
+		panic("params: MustValue called on an absent TimeOnly")
+	}
+	return dst.value
+}
+
+// Hour returns the hour component, or zero if the time is not present.
+//
+// Returns:
+//   - int: The hour in the range [0, 23].
+func (dst *TimeOnly) Hour() int {
+	return int(dst.Value() / time.Hour)
+}
+
+// Minute returns the minute component, or zero if the time is not present.
+//
+// Returns:
+//   - int: The minute in the range [0, 59].
+func (dst *TimeOnly) Minute() int {
+	return int(dst.Value() % time.Hour / time.Minute)
+}
+
+// Second returns the second component, or zero if the time is not present.
+//
+// Returns:
+//   - int: The second in the range [0, 59].
+func (dst *TimeOnly) Second() int {
+	return int(dst.Value() % time.Minute / time.Second)
+}
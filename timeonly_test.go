@@ -0,0 +1,83 @@
+package params
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeOnly(t *testing.T) {
+	type result struct {
+		At TimeOnly `json:"at"`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		output  string
+		hour    int
+		minute  int
+		second  int
+		present bool
+		wantErr bool
+	}{
+		{name: "with seconds", input: `{"at":"14:30:15"}`, hour: 14, minute: 30, second: 15, present: true},
+		{name: "without seconds", input: `{"at":"14:30"}`, output: `{"at":"14:30:00"}`, hour: 14, minute: 30, present: true},
+		{name: "midnight", input: `{"at":"00:00:00"}`, present: true},
+		{name: "end of day", input: `{"at":"23:59:59"}`, hour: 23, minute: 59, second: 59, present: true},
+		{name: "null", input: `{"at":null}`},
+		{name: "missing", input: `{}`, output: `{"at":null}`},
+		{name: "hour out of range", input: `{"at":"24:00:00"}`, wantErr: true},
+		{name: "with date", input: `{"at":"2023-10-05T14:30:00Z"}`, wantErr: true},
+		{name: "malformed", input: `{"at":"half past two"}`, wantErr: true},
+		{name: "fractional seconds", input: `{"at":"14:30:00.9"}`, wantErr: true},
+		{name: "zero fraction", input: `{"at":"14:30:00.000"}`, wantErr: true},
+		{name: "comma fraction", input: `{"at":"14:30:00,5"}`, wantErr: true},
+		{name: "number", input: `{"at":1430}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.output == "" {
+				tt.output = tt.input
+			}
+			var test result
+			err := json.Unmarshal([]byte(tt.input), &test)
+			if tt.wantErr {
+				require.Error(t, err, "Unmarshal should return an error")
+				require.False(t, test.At.Present(), "TimeOnly should not be present")
+				return
+			}
+			require.NoError(t, err, "Unmarshal should not return an error")
+			require.Equal(t, tt.present, test.At.Present(), "Present mismatch")
+			require.Equal(t, tt.hour, test.At.Hour(), "Hour mismatch")
+			require.Equal(t, tt.minute, test.At.Minute(), "Minute mismatch")
+			require.Equal(t, tt.second, test.At.Second(), "Second mismatch")
+
+			js, err := json.Marshal(test)
+			require.NoError(t, err, "Marshal should not return an error")
+			require.JSONEq(t, tt.output, string(js), "Marshalled JSON mismatch")
+		})
+	}
+}
+
+func TestTimeOnly_Set(t *testing.T) {
+	var dst TimeOnly
+	require.Error(t, dst.Set(12, 60, 0), "Set should reject invalid minute")
+	require.False(t, dst.Present(), "TimeOnly should not be present after failed Set")
+
+	require.NoError(t, dst.Set(9, 5, 7), "Set should accept a valid time")
+	js, err := json.Marshal(dst)
+	require.NoError(t, err, "Marshal should not return an error")
+	require.Equal(t, `"09:05:07"`, string(js), "Marshalled JSON mismatch")
+}
+
+func TestTimeOnly_MustValue(t *testing.T) {
+	var dst TimeOnly
+	require.Panics(t, func() { dst.MustValue() }, "MustValue should panic when absent")
+
+	require.NoError(t, dst.Set(14, 30, 0), "Set should not return an error")
+	require.Equal(t, 14*time.Hour+30*time.Minute, dst.MustValue(), "MustValue mismatch")
+}